@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// auditRecord is a single API call audit log entry.
+// Request and response payloads and error messages are never recorded.
+// The provider only knows the type of the resource making an API call, not its address in the Terraform configuration.
+// Any request or error metadata added to the record must first be masked, as logging.MaskSensitiveValuesByKey does for log entries.
+type auditRecord struct {
+	Time           time.Time `json:"time"`
+	ResourceType   string    `json:"resource_type,omitempty"`
+	Phase          string    `json:"phase,omitempty"`
+	ServicePackage string    `json:"service_package"`
+	Service        string    `json:"service"`
+	Operation      string    `json:"operation"`
+	Region         string    `json:"region,omitempty"`
+	RequestID      string    `json:"request_id,omitempty"`
+	LatencyMillis  int64     `json:"latency_ms"`
+	RetryCount     int       `json:"retry_count"`
+	Success        bool      `json:"success"`
+	ErrorCode      string    `json:"error_code,omitempty"`
+}
+
+// auditLog writes a JSON Lines record for each AWS API call.
+// It is shared by all of the provider's API clients.
+type auditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// openAuditLogs holds the audit logs opened by the provider process, keyed by absolute path.
+// The provider may be configured many times, e.g. once per provider alias, and each audit log file is opened only once.
+// Files are closed when the provider process exits.
+var openAuditLogs = struct {
+	mu   sync.Mutex
+	logs map[string]*auditLog
+}{
+	logs: make(map[string]*auditLog),
+}
+
+// openAuditLog returns the audit log for the specified file, opening the file for appending, and creating it, if necessary.
+func openAuditLog(path string) (*auditLog, error) {
+	absPath, err := filepath.Abs(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API call audit log (%s): %w", path, err)
+	}
+
+	openAuditLogs.mu.Lock()
+	defer openAuditLogs.mu.Unlock()
+
+	if l, ok := openAuditLogs.logs[absPath]; ok {
+		return l, nil
+	}
+
+	f, err := os.OpenFile(absPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API call audit log (%s): %w", path, err)
+	}
+
+	l := newAuditLog(f)
+	openAuditLogs.logs[absPath] = l
+
+	return l, nil
+}
+
+func newAuditLog(w io.Writer) *auditLog {
+	return &auditLog{
+		w: w,
+	}
+}
+
+func (l *auditLog) write(record auditRecord) error {
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that adds the audit log middleware to the specified service package's API client stacks.
+// The middleware runs at the end of the Initialize step so that a single record covers all of an API call's attempts.
+func (l *auditLog) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		const id = "TerraformAuditLog"
+		mw := middleware.InitializeMiddlewareFunc(id, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			record := newAuditRecord(ctx, servicePackageName, start, metadata, err)
+			if err := l.write(record); err != nil {
+				tflog.Warn(ctx, "writing API call audit log", map[string]any{
+					"error": err.Error(),
+				})
+			}
+
+			return out, metadata, err
+		})
+
+		return stack.Initialize.Add(mw, middleware.After)
+	}
+}
+
+func newAuditRecord(ctx context.Context, servicePackageName string, start time.Time, metadata middleware.Metadata, err error) auditRecord {
+	record := auditRecord{
+		Time:           start.UTC(),
+		ServicePackage: servicePackageName,
+		Service:        awsmiddleware.GetServiceID(ctx),
+		Operation:      awsmiddleware.GetOperationName(ctx),
+		Region:         awsmiddleware.GetRegion(ctx),
+		LatencyMillis:  time.Since(start).Milliseconds(),
+		Success:        err == nil,
+	}
+
+	if v, ok := CRUDFromContext(ctx); ok {
+		record.ResourceType = v.TypeName()
+		record.Phase = v.Phase()
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		record.RequestID = v
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+		record.RetryCount = len(v.Results) - 1
+	}
+
+	if err != nil {
+		if record.RequestID == "" {
+			var requestIDErr interface{ ServiceRequestID() string }
+			if errors.As(err, &requestIDErr) {
+				record.RequestID = requestIDErr.ServiceRequestID()
+			}
+		}
+
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			record.ErrorCode = apiErr.ErrorCode()
+		}
+	}
+
+	return record
+}
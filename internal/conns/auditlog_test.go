@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		expected auditRecord
+	}{
+		{
+			name: "success",
+			ctx:  NewCRUDContext(context.Background(), "aws_lambda_function", "create"),
+			expected: auditRecord{
+				ResourceType:   "aws_lambda_function",
+				Phase:          "create",
+				ServicePackage: names.Lambda,
+				Service:        "Lambda",
+				Operation:      "CreateFunction",
+				Region:         "us-west-2",
+				RequestID:      "request-1",
+				Success:        true,
+			},
+		},
+		{
+			name: "API error",
+			ctx:  NewCRUDContext(context.Background(), "aws_lambda_function", "delete"),
+			err:  &smithy.GenericAPIError{Code: "ResourceConflictException", Message: "secret"},
+			expected: auditRecord{
+				ResourceType:   "aws_lambda_function",
+				Phase:          "delete",
+				ServicePackage: names.Lambda,
+				Service:        "Lambda",
+				Operation:      "CreateFunction",
+				Region:         "us-west-2",
+				RequestID:      "request-1",
+				ErrorCode:      "ResourceConflictException",
+			},
+		},
+		{
+			name: "no CRUD handler",
+			ctx:  context.Background(),
+			expected: auditRecord{
+				ServicePackage: names.Lambda,
+				Service:        "Lambda",
+				Operation:      "CreateFunction",
+				Region:         "us-west-2",
+				RequestID:      "request-1",
+				Success:        true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			l := newAuditLog(&buf)
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "Lambda",
+				Region:        "us-west-2",
+				OperationName: "CreateFunction",
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := l.apiOption(names.Lambda)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				var metadata middleware.Metadata
+				awsmiddleware.SetRequestIDMetadata(&metadata, "request-1")
+				return nil, metadata, testCase.err
+			}), stack)
+
+			if _, _, err := handler.Handle(testCase.ctx, struct{}{}); err != testCase.err { //nolint:errorlint // Expect the error unchanged
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got auditRecord
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshaling audit record: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreFields(auditRecord{}, "Time", "LatencyMillis")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if bytes.Contains(buf.Bytes(), []byte("secret")) {
+				t.Errorf("audit record contains error message: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestOpenAuditLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	l1, err := openAuditLog(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	l2, err := openAuditLog(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if l1 != l2 {
+		t.Error("audit log opened more than once")
+	}
+}
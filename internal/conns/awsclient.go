@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -24,24 +25,33 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel"
 )
 
 type AWSClient struct {
 	accountID                 string
+	auditLog                  *auditLog // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
+	defaultTimeouts           map[string]ResourceTimeouts // From provider configuration.
+	dryRunReport              *dryRunReport               // From provider configuration.
+	endpoints                 map[string]string           // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*serviceRateLimiter  // From provider configuration.
+	retryableErrors           map[string][]RetryableErrorRule // From provider configuration.
 	region                    string
+	regionGuardrails          regionGuardrails // From provider configuration.
 	servicePackages           map[string]ServicePackage
+	tagPolicyConfig           *tftags.PolicyConfig
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
+	s3ExpressClients          map[string]*s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
@@ -79,8 +89,14 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -103,7 +119,20 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If a per-resource Region override is in effect, that Region is returned.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion(); v != "" {
+			return v
+		}
+	}
+
+	return c.region
+}
+
+// ProviderRegion returns the ID of the AWS Region configured on the provider,
+// ignoring any per-resource Region override.
+func (c *AWSClient) ProviderRegion(context.Context) string {
 	return c.region
 }
 
@@ -167,22 +196,29 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
+	region := c.Region(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3.Client)
+	}
+
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -316,10 +352,52 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	// Any per-resource Region override is applied to the AWS SDK for Go v2 configuration.
+	if region := c.Region(ctx); awsConfig != nil && region != awsConfig.Region {
+		tflog.Debug(ctx, "overriding provider-configured AWS API region", map[string]any{
+			"original_region": awsConfig.Region,
+			"override_region": region,
+		})
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+	// Any client-side rate limits are enforced in the API client's middleware stack.
+	if limiter, ok := c.rateLimiters[servicePackageName]; ok && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), limiter.addToStack)
+		awsConfig = &cfg
+	}
+	// Any API call audit log is written by the API client's middleware stack.
+	if c.auditLog != nil && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), c.auditLog.apiOption(servicePackageName))
+		awsConfig = &cfg
+	}
+	// Any API call spans are created by the API client's middleware stack.
+	if tracing.Enabled() && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), tracingAPIOptions(otel.GetTracerProvider(), servicePackageName)...)
+		awsConfig = &cfg
+	}
+	// Any mutating API calls are short-circuited in dry-run mode by the API client's middleware stack.
+	// The middleware is added last so that it is the innermost Deserialize step middleware.
+	if c.dryRunReport != nil && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), c.dryRunReport.apiOption(servicePackageName))
+		awsConfig = &cfg
+	}
+	// Any additional retryable errors are handled by the API client's Retryer.
+	if rules, ok := c.retryableErrors[servicePackageName]; ok && awsConfig != nil {
+		awsConfig = withRetryableErrorRules(awsConfig, rules)
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
+		names.AttrRegion:   c.Region(ctx),
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -338,18 +416,19 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per AWS Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := clientCacheKey(servicePackageName, c.Region(ctx))
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -384,8 +463,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// clientCacheKey returns the key used to cache the default AWS SDK for Go v2 API client for the specified service and AWS Region.
+func clientCacheKey(servicePackageName, region string) string {
+	return servicePackageName + "/" + region
+}
@@ -184,3 +184,48 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Expected:       "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "Test", "Test", testCase.OverrideRegion)
+
+			if got, want := testCase.AWSClient.Region(ctx), testCase.Expected; got != want {
+				t.Errorf("Region = %s, want %s", got, want)
+			}
+
+			if got, want := testCase.AWSClient.ProviderRegion(ctx), testCase.AWSClient.region; got != want {
+				t.Errorf("ProviderRegion = %s, want %s", got, want)
+			}
+		})
+	}
+}
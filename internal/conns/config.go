@@ -29,15 +29,21 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedPartitions              []string
+	AllowedRegions                 []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeouts                map[string]ResourceTimeouts
+	DryRunReportPath               string
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
@@ -45,7 +51,9 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string]ServiceRateLimits
 	Region                         string
+	RetryableErrors                map[string][]RetryableErrorRule
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -57,6 +65,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -163,6 +172,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	client.regionGuardrails = regionGuardrails{
+		allowedPartitions: c.AllowedPartitions,
+		allowedRegions:    c.AllowedRegions,
+		forbiddenRegions:  c.ForbiddenRegions,
+	}
+	if err := client.regionGuardrails.verifyRegionAllowed(c.Region); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -208,9 +226,25 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	if c.AuditLogPath != "" {
+		auditLog, err := openAuditLog(c.AuditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.auditLog = auditLog
+	}
+	if c.DryRunReportPath != "" {
+		dryRunReport, err := openDryRunReport(c.DryRunReportPath)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.dryRunReport = dryRunReport
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeouts = c.DefaultTimeouts
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -219,6 +253,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = make(map[string]*serviceRateLimiter, len(c.RateLimits))
+	for servicePackageName, limits := range c.RateLimits {
+		client.rateLimiters[servicePackageName] = newServiceRateLimiter(servicePackageName, limits)
+	}
+	client.retryableErrors = c.RetryableErrors
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
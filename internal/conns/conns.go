@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources.
+// List resources are used to discover existing instances of the package's Plugin SDK resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -38,6 +45,7 @@ var (
 type InContext struct {
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
+	overrideRegion      string // Any currently in effect per-resource Region override.
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
 }
@@ -52,6 +60,11 @@ func (c *InContext) IsEphemeralResource() bool {
 	return c.isEphemeralResource
 }
 
+// OverrideRegion returns any currently in effect per-resource Region override.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
@@ -62,9 +75,10 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		isDataSource:       true,
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
 	}
@@ -72,9 +86,10 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
+		overrideRegion:      overrideRegion,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
 	}
@@ -82,8 +97,9 @@ func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resour
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
 	}
@@ -95,3 +111,41 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+type (
+	crudContextKeyType int
+)
+
+var (
+	crudContextKey crudContextKeyType
+)
+
+// InCRUDContext represents the information about an in-progress CRUD handler kept in Context.
+type InCRUDContext struct {
+	phase    string // CRUD handler, e.g. "create"
+	typeName string // Terraform type name, e.g. "aws_subnet"
+}
+
+// Phase returns the CRUD handler, e.g. "create".
+func (c *InCRUDContext) Phase() string {
+	return c.phase
+}
+
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InCRUDContext) TypeName() string {
+	return c.typeName
+}
+
+func NewCRUDContext(ctx context.Context, typeName, phase string) context.Context {
+	v := InCRUDContext{
+		phase:    phase,
+		typeName: typeName,
+	}
+
+	return context.WithValue(ctx, crudContextKey, &v)
+}
+
+func CRUDFromContext(ctx context.Context) (*InCRUDContext, bool) {
+	v, ok := ctx.Value(crudContextKey).(*InCRUDContext)
+	return v, ok
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DryRunReportPathEnvVar is the environment variable that sets the dry-run report path if the provider configuration does not.
+	DryRunReportPathEnvVar = "TF_AWS_DRY_RUN_REPORT_PATH"
+)
+
+// readOnlyOperationPrefixes holds the API operation name prefixes of operations that do not modify AWS resources.
+// Operations with any other name are not sent to AWS in dry-run mode.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Decode",
+	"Describe",
+	"Estimate",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Validate",
+}
+
+// dryRunRedactedHeaders holds the canonical names of request headers that are not recorded as they contain credentials.
+var dryRunRedactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+}
+
+// dryRunRecord is a single dry-run report entry, describing an API call that was not sent to AWS.
+// The request is recorded as it would have been sent, after serialization and signing, apart from any credential headers.
+type dryRunRecord struct {
+	Time           time.Time         `json:"time"`
+	ResourceType   string            `json:"resource_type,omitempty"`
+	Phase          string            `json:"phase,omitempty"`
+	ServicePackage string            `json:"service_package"`
+	Service        string            `json:"service"`
+	Operation      string            `json:"operation"`
+	Region         string            `json:"region,omitempty"`
+	Method         string            `json:"method"`
+	URL            string            `json:"url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Body           string            `json:"body,omitempty"`
+	BodyEncoding   string            `json:"body_encoding,omitempty"`
+}
+
+// dryRunReport writes a JSON Lines record for each mutating AWS API call and returns a synthetic successful response in place of calling AWS.
+// It is shared by all of the provider's API clients.
+type dryRunReport struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// openDryRunReports holds the dry-run reports opened by the provider process, keyed by absolute path.
+// The provider may be configured many times, e.g. once per provider alias, and each report file is opened only once.
+// Files are closed when the provider process exits.
+var openDryRunReports = struct {
+	mu      sync.Mutex
+	reports map[string]*dryRunReport
+}{
+	reports: make(map[string]*dryRunReport),
+}
+
+// openDryRunReport returns the dry-run report for the specified file, opening the file for appending, and creating it, if necessary.
+func openDryRunReport(path string) (*dryRunReport, error) {
+	absPath, err := filepath.Abs(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening dry-run report (%s): %w", path, err)
+	}
+
+	openDryRunReports.mu.Lock()
+	defer openDryRunReports.mu.Unlock()
+
+	if r, ok := openDryRunReports.reports[absPath]; ok {
+		return r, nil
+	}
+
+	f, err := os.OpenFile(absPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening dry-run report (%s): %w", path, err)
+	}
+
+	r := newDryRunReport(f)
+	openDryRunReports.reports[absPath] = r
+
+	return r, nil
+}
+
+func newDryRunReport(w io.Writer) *dryRunReport {
+	return &dryRunReport{
+		w: w,
+	}
+}
+
+func (r *dryRunReport) write(record dryRunRecord) error {
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err = r.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that adds the dry-run middleware to the specified service package's API client stacks.
+// The middleware runs at the end of the Deserialize step, immediately before the request would be sent, so that the recorded request is exactly what AWS would receive.
+func (r *dryRunReport) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		const id = "TerraformDryRun"
+		mw := middleware.DeserializeMiddlewareFunc(id, func(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if isReadOnlyOperation(operation) {
+				return next.HandleDeserialize(ctx, in)
+			}
+
+			req, ok := in.Request.(*smithyhttp.Request)
+			if !ok {
+				return middleware.DeserializeOutput{}, middleware.Metadata{}, fmt.Errorf("dry-run: unexpected request type %T", in.Request)
+			}
+
+			record, err := newDryRunRecord(ctx, servicePackageName, req)
+			if err != nil {
+				return middleware.DeserializeOutput{}, middleware.Metadata{}, fmt.Errorf("dry-run: reading %s request: %w", operation, err)
+			}
+
+			if err := r.write(record); err != nil {
+				return middleware.DeserializeOutput{}, middleware.Metadata{}, fmt.Errorf("dry-run: writing report: %w", err)
+			}
+
+			tflog.Info(ctx, "dry-run: API call not sent to AWS", map[string]any{
+				"aws.service":   record.Service,
+				"aws.operation": record.Operation,
+			})
+
+			return middleware.DeserializeOutput{
+				RawResponse: &smithyhttp.Response{
+					Response: &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{},
+						Body:       http.NoBody,
+					},
+				},
+			}, middleware.Metadata{}, nil
+		})
+
+		return stack.Deserialize.Add(mw, middleware.After)
+	}
+}
+
+func isReadOnlyOperation(operation string) bool {
+	return slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operation, prefix)
+	})
+}
+
+func newDryRunRecord(ctx context.Context, servicePackageName string, req *smithyhttp.Request) (dryRunRecord, error) {
+	record := dryRunRecord{
+		Time:           time.Now().UTC(),
+		ServicePackage: servicePackageName,
+		Service:        awsmiddleware.GetServiceID(ctx),
+		Operation:      awsmiddleware.GetOperationName(ctx),
+		Region:         awsmiddleware.GetRegion(ctx),
+		Method:         req.Method,
+		URL:            req.URL.String(),
+	}
+
+	if v, ok := CRUDFromContext(ctx); ok {
+		record.ResourceType = v.TypeName()
+		record.Phase = v.Phase()
+	}
+
+	for k, v := range req.Header {
+		if k = http.CanonicalHeaderKey(k); slices.Contains(dryRunRedactedHeaders, k) {
+			continue
+		}
+		if record.Headers == nil {
+			record.Headers = make(map[string]string)
+		}
+		record.Headers[k] = strings.Join(v, ",")
+	}
+
+	if stream := req.GetStream(); stream != nil {
+		body, err := io.ReadAll(stream)
+
+		if err != nil {
+			return record, err
+		}
+
+		if utf8.Valid(body) {
+			record.Body = string(body)
+		} else {
+			record.Body = base64.StdEncoding.EncodeToString(body)
+			record.BodyEncoding = "base64"
+		}
+	}
+
+	return record, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDryRunReport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		operation     string
+		body          []byte
+		expectSent    bool
+		expectRecords []dryRunRecord
+	}{
+		{
+			name:       "read-only",
+			operation:  "GetFunction",
+			expectSent: true,
+		},
+		{
+			name:      "mutating",
+			operation: "CreateFunction",
+			body:      []byte(`{"FunctionName":"example"}`),
+			expectRecords: []dryRunRecord{
+				{
+					ResourceType:   "aws_lambda_function",
+					Phase:          "create",
+					ServicePackage: names.Lambda,
+					Service:        "Lambda",
+					Operation:      "CreateFunction",
+					Region:         "us-west-2",
+					Method:         "POST",
+					URL:            "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions",
+					Headers: map[string]string{
+						"Content-Type": "application/json",
+					},
+					Body: `{"FunctionName":"example"}`,
+				},
+			},
+		},
+		{
+			name:      "binary body",
+			operation: "UpdateFunctionCode",
+			body:      []byte{0xff, 0xfe},
+			expectRecords: []dryRunRecord{
+				{
+					ResourceType:   "aws_lambda_function",
+					Phase:          "create",
+					ServicePackage: names.Lambda,
+					Service:        "Lambda",
+					Operation:      "UpdateFunctionCode",
+					Region:         "us-west-2",
+					Method:         "POST",
+					URL:            "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions",
+					Headers: map[string]string{
+						"Content-Type": "application/json",
+					},
+					Body:         "//4=",
+					BodyEncoding: "base64",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			r := newDryRunReport(&buf)
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "Lambda",
+				Region:        "us-west-2",
+				OperationName: testCase.operation,
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := stack.Serialize.Add(middleware.SerializeMiddlewareFunc("testRequest", func(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (middleware.SerializeOutput, middleware.Metadata, error) {
+				req := in.Request.(*smithyhttp.Request)
+				req.Method = "POST"
+				req.URL.Scheme = "https"
+				req.URL.Host = "lambda.us-west-2.amazonaws.com"
+				req.URL.Path = "/2015-03-31/functions"
+				req.Header.Set("Authorization", "secret")
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("X-Amz-Security-Token", "secret")
+				if testCase.body != nil {
+					var err error
+					if req, err = req.SetStream(bytes.NewReader(testCase.body)); err != nil {
+						return middleware.SerializeOutput{}, middleware.Metadata{}, err
+					}
+					in.Request = req
+				}
+				return next.HandleSerialize(ctx, in)
+			}), middleware.After); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.apiOption(names.Lambda)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var sent bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				sent = true
+				return &smithyhttp.Response{}, middleware.Metadata{}, nil
+			}), stack)
+
+			ctx := NewCRUDContext(context.Background(), "aws_lambda_function", "create")
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := sent, testCase.expectSent; got != want {
+				t.Errorf("request sent = %t, want %t", got, want)
+			}
+
+			var got []dryRunRecord
+			for line := range bytes.Lines(buf.Bytes()) {
+				var record dryRunRecord
+				if err := json.Unmarshal(line, &record); err != nil {
+					t.Fatalf("unmarshaling dry-run record: %s", err)
+				}
+				got = append(got, record)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectRecords, cmpopts.IgnoreFields(dryRunRecord{}, "Time")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if bytes.Contains(buf.Bytes(), []byte("secret")) {
+				t.Errorf("dry-run record contains credentials: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"BatchGetItem":            true,
+		"BatchWriteItem":          false,
+		"CreateFunction":          false,
+		"DeleteBucket":            false,
+		"DescribeInstances":       true,
+		"GetCallerIdentity":       true,
+		"ListTagsForResource":     true,
+		"ModifyInstanceAttribute": false,
+		"PutObject":               false,
+		"TagResource":             false,
+		"UpdateFunctionCode":      false,
+		"ValidateTemplate":        true,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOnlyOperation(operation); got != want {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
+
+func TestOpenDryRunReport(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dry-run.jsonl")
+
+	r1, err := openDryRunReport(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r2, err := openDryRunReport(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if r1 != r2 {
+		t.Error("dry-run report opened more than once")
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"maps"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimit is a client-side limit on the rate of AWS API requests.
+type RateLimit struct {
+	// Burst is the maximum number of requests that can be made at once.
+	// If zero, TokensPerSecond rounded up is used.
+	Burst int
+	// TokensPerSecond is the maximum sustained number of requests per second.
+	// The rate is reduced while the service returns throttling errors.
+	TokensPerSecond float64
+}
+
+// ServiceRateLimits is the client-side rate limit configuration for a single service package.
+type ServiceRateLimits struct {
+	// RateLimit applies to all of the service's API operations without an override.
+	// If TokensPerSecond is zero, only the overridden operations are limited.
+	RateLimit
+	// Operations holds per-operation overrides, keyed by API operation name (e.g. "ChangeResourceRecordSets").
+	// Requests to an overridden operation don't count against the service-wide limit.
+	Operations map[string]RateLimit
+}
+
+// serviceRateLimiter enforces a service package's rate limits.
+// It is shared by all of the service's API clients.
+type serviceRateLimiter struct {
+	operations         map[string]*tokenBucket
+	service            *tokenBucket
+	servicePackageName string
+}
+
+func newServiceRateLimiter(servicePackageName string, limits ServiceRateLimits) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		operations:         make(map[string]*tokenBucket, len(limits.Operations)),
+		servicePackageName: servicePackageName,
+	}
+
+	if limits.TokensPerSecond > 0 {
+		l.service = newTokenBucket(limits.RateLimit)
+	}
+
+	for operation, limit := range limits.Operations {
+		if limit.TokensPerSecond > 0 {
+			l.operations[operation] = newTokenBucket(limit)
+		}
+	}
+
+	return l
+}
+
+// bucket returns the token bucket applicable to the specified API operation, or nil if the operation is not rate limited.
+func (l *serviceRateLimiter) bucket(operation string) *tokenBucket {
+	if v, ok := l.operations[operation]; ok {
+		return v
+	}
+
+	return l.service
+}
+
+// addToStack adds the rate limiting middleware to an AWS SDK for Go v2 API client's middleware stack.
+// The middleware runs after the SDK's retry middleware so that every attempt is rate limited.
+func (l *serviceRateLimiter) addToStack(stack *middleware.Stack) error {
+	const id = "TerraformRateLimit"
+	mw := middleware.FinalizeMiddlewareFunc(id, l.handleFinalize)
+
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(mw, "Retry", middleware.After)
+	}
+
+	return stack.Finalize.Add(mw, middleware.Before)
+}
+
+func (l *serviceRateLimiter) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	fields := map[string]any{
+		"tf_aws.service_package": l.servicePackageName,
+		"rpc.service":            awsmiddleware.GetServiceID(ctx),
+		"rpc.method":             operation,
+	}
+
+	bucket := l.bucket(operation)
+
+	if bucket != nil {
+		delay, err := bucket.wait(ctx)
+
+		if err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		if delay > 0 {
+			tflog.Debug(ctx, "AWS API request delayed by client-side rate limit", withField(fields, "tf_aws.rate_limit.delay", delay.String()))
+		}
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	switch {
+	case err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary:
+		fields = withField(fields, "error", err.Error())
+		if bucket != nil {
+			fields = withField(fields, "tf_aws.rate_limit.tokens_per_second", bucket.throttled(time.Now()))
+		}
+		tflog.Warn(ctx, "AWS API request throttled", fields)
+	case err == nil && bucket != nil:
+		bucket.succeeded(time.Now())
+	}
+
+	return out, metadata, err
+}
+
+func withField(fields map[string]any, k string, v any) map[string]any {
+	m := maps.Clone(fields)
+	m[k] = v
+
+	return m
+}
+
+const (
+	// throttleRateFactor is the factor by which a token bucket's rate is reduced on a throttling error.
+	throttleRateFactor = 0.7
+	// minThrottledRate is the minimum rate, in tokens per second, to which throttling errors reduce a token bucket's rate.
+	minThrottledRate = 0.5
+	// recoveryRateFraction is the fraction of a token bucket's configured rate by which its rate is increased
+	// on each successful request, until the configured rate is reached.
+	recoveryRateFraction = 0.05
+)
+
+// tokenBucket is an adaptive token bucket rate limiter.
+// The bucket starts full and is refilled continuously at the current rate.
+// The current rate starts at the configured rate, is reduced multiplicatively on each throttling error
+// and is increased additively on each successful request, never exceeding the configured rate.
+type tokenBucket struct {
+	burst   float64
+	last    time.Time
+	maxRate float64
+	mu      sync.Mutex
+	rate    float64
+	tokens  float64
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = max(1, math.Ceil(limit.TokensPerSecond))
+	}
+
+	return &tokenBucket{
+		burst:   burst,
+		maxRate: limit.TokensPerSecond,
+		rate:    limit.TokensPerSecond,
+		tokens:  burst,
+	}
+}
+
+// refill adds the tokens accumulated since the last refill at the current rate.
+// Must be called with the lock held.
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before the token is available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// throttled reduces the bucket's rate after a throttling error and returns the new rate.
+func (b *tokenBucket) throttled(now time.Time) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.rate = max(min(b.maxRate, minThrottledRate), b.rate*throttleRateFactor)
+
+	return b.rate
+}
+
+// succeeded increases the bucket's rate towards the configured rate after a successful request.
+func (b *tokenBucket) succeeded(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate >= b.maxRate {
+		return
+	}
+
+	b.refill(now)
+	b.rate = min(b.maxRate, b.rate+b.maxRate*recoveryRateFraction)
+}
+
+// cancel returns a previously reserved token to the bucket.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available or the context is done and returns the time spent waiting.
+func (b *tokenBucket) wait(ctx context.Context) (time.Duration, error) {
+	delay := b.reserve(time.Now())
+
+	if delay == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return delay, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
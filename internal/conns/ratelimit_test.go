@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		limit    RateLimit
+		offsets  []time.Duration
+		expected []time.Duration
+	}{
+		{
+			name:     "within burst",
+			limit:    RateLimit{Burst: 3, TokensPerSecond: 1},
+			offsets:  []time.Duration{0, 0, 0},
+			expected: []time.Duration{0, 0, 0},
+		},
+		{
+			name:     "burst exceeded",
+			limit:    RateLimit{Burst: 2, TokensPerSecond: 2},
+			offsets:  []time.Duration{0, 0, 0, 0},
+			expected: []time.Duration{0, 0, 500 * time.Millisecond, time.Second},
+		},
+		{
+			name:     "default burst",
+			limit:    RateLimit{TokensPerSecond: 0.5},
+			offsets:  []time.Duration{0, 0},
+			expected: []time.Duration{0, 2 * time.Second},
+		},
+		{
+			name:     "refill",
+			limit:    RateLimit{Burst: 1, TokensPerSecond: 10},
+			offsets:  []time.Duration{0, 100 * time.Millisecond, 150 * time.Millisecond},
+			expected: []time.Duration{0, 0, 50 * time.Millisecond},
+		},
+		{
+			name:     "refill capped at burst",
+			limit:    RateLimit{Burst: 1, TokensPerSecond: 10},
+			offsets:  []time.Duration{0, 10 * time.Second, 10 * time.Second},
+			expected: []time.Duration{0, 0, 100 * time.Millisecond},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket := newTokenBucket(testCase.limit)
+
+			for i, offset := range testCase.offsets {
+				got := bucket.reserve(now.Add(offset)).Round(time.Millisecond)
+
+				if want := testCase.expected[i]; got != want {
+					t.Errorf("reserve %d = %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenBucketAdaptiveRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	testCases := []struct {
+		name      string
+		limit     RateLimit
+		throttles int
+		successes int
+		expected  float64
+	}{
+		{
+			name:      "throttled",
+			limit:     RateLimit{TokensPerSecond: 10},
+			throttles: 2,
+			expected:  4.9,
+		},
+		{
+			name:      "throttled minimum",
+			limit:     RateLimit{TokensPerSecond: 1},
+			throttles: 10,
+			expected:  0.5,
+		},
+		{
+			name:      "throttled minimum above configured rate",
+			limit:     RateLimit{TokensPerSecond: 0.2},
+			throttles: 10,
+			expected:  0.2,
+		},
+		{
+			name:      "recovering",
+			limit:     RateLimit{TokensPerSecond: 10},
+			throttles: 1,
+			successes: 2,
+			expected:  8,
+		},
+		{
+			name:      "recovered",
+			limit:     RateLimit{TokensPerSecond: 10},
+			throttles: 1,
+			successes: 100,
+			expected:  10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket := newTokenBucket(testCase.limit)
+
+			for range testCase.throttles {
+				bucket.throttled(now)
+			}
+			for range testCase.successes {
+				bucket.succeeded(now)
+			}
+
+			if got, want := bucket.rate, testCase.expected; got < want-0.001 || got > want+0.001 {
+				t.Errorf("rate = %f, want %f", got, want)
+			}
+		})
+	}
+}
+
+func TestTokenBucketWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	bucket := newTokenBucket(RateLimit{Burst: 1, TokensPerSecond: 0.001})
+
+	if _, err := bucket.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := bucket.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait error = %v, want %v", err, context.Canceled)
+	}
+
+	if got, want := bucket.tokens, float64(0); got < want-0.01 || got > want+0.01 {
+		t.Errorf("tokens = %f, want %f", got, want)
+	}
+}
+
+func TestServiceRateLimiterBucket(t *testing.T) {
+	t.Parallel()
+
+	limiter := newServiceRateLimiter("route53", ServiceRateLimits{
+		RateLimit: RateLimit{TokensPerSecond: 5},
+		Operations: map[string]RateLimit{
+			"ChangeResourceRecordSets": {TokensPerSecond: 1},
+		},
+	})
+
+	if limiter.bucket("ListHostedZones") != limiter.service {
+		t.Error("expected service-wide bucket for operation without an override")
+	}
+
+	if got := limiter.bucket("ChangeResourceRecordSets"); got == nil || got == limiter.service {
+		t.Error("expected operation bucket for overridden operation")
+	}
+
+	limiter = newServiceRateLimiter("iam", ServiceRateLimits{
+		Operations: map[string]RateLimit{
+			"CreateRole": {TokensPerSecond: 1},
+		},
+	})
+
+	if limiter.bucket("GetRole") != nil {
+		t.Error("expected no bucket for operation without a service-wide limit")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionGuardrails restricts the Regions and partitions in which the provider may operate.
+type regionGuardrails struct {
+	allowedPartitions []string
+	allowedRegions    []string
+	forbiddenRegions  []string
+}
+
+// verifyRegionAllowed returns an error if operating in the specified Region is not permitted.
+func (g *regionGuardrails) verifyRegionAllowed(region string) error {
+	if slices.Contains(g.forbiddenRegions, region) {
+		return fmt.Errorf("operating in Region (%s) is forbidden by forbidden_regions", region)
+	}
+
+	if len(g.allowedRegions) > 0 && !slices.Contains(g.allowedRegions, region) {
+		return fmt.Errorf("operating in Region (%s) is not allowed by allowed_regions", region)
+	}
+
+	if len(g.allowedPartitions) > 0 {
+		if partition := names.PartitionForRegion(region).ID(); !slices.Contains(g.allowedPartitions, partition) {
+			return fmt.Errorf("operating in Region (%s) of partition (%s) is not allowed by allowed_partitions", region, partition)
+		}
+	}
+
+	return nil
+}
+
+// VerifyRegionAllowed returns an error if the provider's allowed_regions, forbidden_regions or allowed_partitions
+// do not permit operating in the specified Region, e.g. a per-resource Region override.
+func (c *AWSClient) VerifyRegionAllowed(_ context.Context, region string) error {
+	return c.regionGuardrails.verifyRegionAllowed(region)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestRegionGuardrailsVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		guardrails regionGuardrails
+		region     string
+		wantErr    bool
+	}{
+		"no guardrails": {
+			region: "eu-west-1",
+		},
+		"allowed Region": {
+			guardrails: regionGuardrails{allowedRegions: []string{"us-east-1", "us-west-2"}},
+			region:     "us-west-2",
+		},
+		"not allowed Region": {
+			guardrails: regionGuardrails{allowedRegions: []string{"us-east-1", "us-west-2"}},
+			region:     "eu-west-1",
+			wantErr:    true,
+		},
+		"forbidden Region": {
+			guardrails: regionGuardrails{forbiddenRegions: []string{"us-east-1"}},
+			region:     "us-east-1",
+			wantErr:    true,
+		},
+		"not forbidden Region": {
+			guardrails: regionGuardrails{forbiddenRegions: []string{"us-east-1"}},
+			region:     "us-east-2",
+		},
+		"allowed partition": {
+			guardrails: regionGuardrails{allowedPartitions: []string{"aws"}},
+			region:     "ap-southeast-2",
+		},
+		"not allowed partition": {
+			guardrails: regionGuardrails{allowedPartitions: []string{"aws"}},
+			region:     "us-gov-west-1",
+			wantErr:    true,
+		},
+		"allowed Region in not allowed partition": {
+			guardrails: regionGuardrails{allowedPartitions: []string{"aws-us-gov"}, allowedRegions: []string{"us-east-1"}},
+			region:     "us-east-1",
+			wantErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.guardrails.verifyRegionAllowed(testCase.region)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("verifyRegionAllowed(%q) error = %v, want error: %t", testCase.region, err, want)
+			}
+		})
+	}
+}
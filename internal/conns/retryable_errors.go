@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+// RetryableErrorRule declares an additional retryable error condition for a service package.
+type RetryableErrorRule struct {
+	// ErrorCodes matches an API error whose code is one of the specified codes.
+	// If empty, any error code matches.
+	ErrorCodes []string
+	// ErrorMessage matches an error whose message matches the regular expression.
+	// If nil, any error message matches.
+	ErrorMessage *regexp.Regexp
+	// MaxAttempts is the maximum number of attempts made for a matching error.
+	// If zero, the API client's maximum number of attempts is used.
+	MaxAttempts int
+	// MaxBackoff is the maximum delay between attempts for a matching error.
+	// If zero, the API client's backoff is used.
+	MaxBackoff time.Duration
+}
+
+// match returns whether the specified error matches the rule.
+func (r RetryableErrorRule) match(err error) bool {
+	message := err.Error()
+
+	if len(r.ErrorCodes) > 0 {
+		var apiErr smithy.APIError
+		if !errors.As(err, &apiErr) || !slices.Contains(r.ErrorCodes, apiErr.ErrorCode()) {
+			return false
+		}
+
+		message = apiErr.ErrorMessage()
+	}
+
+	if r.ErrorMessage != nil && !r.ErrorMessage.MatchString(message) {
+		return false
+	}
+
+	return true
+}
+
+// withRetryableErrorRules returns a copy of the AWS SDK for Go v2 configuration whose Retryer
+// also retries errors matching any of the specified rules.
+func withRetryableErrorRules(cfg *aws.Config, rules []RetryableErrorRule) *aws.Config {
+	newRetryer := cfg.Retryer
+	retryMaxAttempts := cfg.RetryMaxAttempts
+
+	v := cfg.Copy()
+	v.Retryer = func() aws.Retryer {
+		var r aws.Retryer
+		if newRetryer != nil {
+			r = newRetryer()
+		} else {
+			r = retry.NewStandard()
+		}
+		if retryMaxAttempts != 0 {
+			r = retry.AddWithMaxAttempts(r, retryMaxAttempts)
+		}
+
+		return newRetryableErrorRulesRetryer(r, rules)
+	}
+	// The API client would otherwise wrap the Retryer, overriding any rule's maximum number of attempts.
+	v.RetryMaxAttempts = 0
+
+	return &v
+}
+
+// retryableErrorRulesRetryer is a Retryer which also retries errors matching any of a set of rules.
+type retryableErrorRulesRetryer struct {
+	aws.RetryerV2
+	maxAttempts int
+	rules       []RetryableErrorRule
+}
+
+func newRetryableErrorRulesRetryer(r aws.Retryer, rules []RetryableErrorRule) *retryableErrorRulesRetryer {
+	v, ok := r.(aws.RetryerV2)
+	if !ok {
+		v = &retryerV2{Retryer: r}
+	}
+
+	return &retryableErrorRulesRetryer{
+		RetryerV2:   v,
+		maxAttempts: r.MaxAttempts(),
+		rules:       rules,
+	}
+}
+
+// rule returns the first rule matching the specified error, or nil if no rule matches.
+func (r *retryableErrorRulesRetryer) rule(err error) *RetryableErrorRule {
+	if err == nil {
+		return nil
+	}
+
+	for i, rule := range r.rules {
+		if rule.match(err) {
+			return &r.rules[i]
+		}
+	}
+
+	return nil
+}
+
+func (r *retryableErrorRulesRetryer) IsErrorRetryable(err error) bool {
+	if r.rule(err) != nil {
+		return true
+	}
+
+	return r.RetryerV2.IsErrorRetryable(err)
+}
+
+// MaxAttempts returns the largest maximum number of attempts of the API client and any rule.
+// The maximum number of attempts for an individual error is enforced in RetryDelay.
+func (r *retryableErrorRulesRetryer) MaxAttempts() int {
+	maxAttempts := r.maxAttempts
+
+	for _, rule := range r.rules {
+		maxAttempts = max(maxAttempts, rule.MaxAttempts)
+	}
+
+	return maxAttempts
+}
+
+// RetryDelay is the only Retryer method that takes the attempt count, so it also stops retries
+// once the applicable maximum number of attempts is reached.
+func (r *retryableErrorRulesRetryer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	maxAttempts := r.maxAttempts
+	rule := r.rule(err)
+
+	if rule != nil && rule.MaxAttempts > 0 {
+		maxAttempts = rule.MaxAttempts
+	}
+
+	if maxAttempts > 0 && attempt >= maxAttempts {
+		return 0, &retry.MaxAttemptsError{
+			Attempt: attempt,
+			Err:     err,
+		}
+	}
+
+	if rule != nil && rule.MaxBackoff > 0 {
+		return retry.NewExponentialJitterBackoff(rule.MaxBackoff).BackoffDelay(attempt, err)
+	}
+
+	return r.RetryerV2.RetryDelay(attempt, err)
+}
+
+// retryerV2 adapts a Retryer to the RetryerV2 interface.
+type retryerV2 struct {
+	aws.Retryer
+}
+
+func (r *retryerV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.GetInitialToken(), nil
+}
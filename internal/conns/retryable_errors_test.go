@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+func TestRetryableErrorRuleMatch(t *testing.T) {
+	t.Parallel()
+
+	iamPropagationErr := &smithy.GenericAPIError{
+		Code:    "InvalidParameterValue",
+		Message: "The role defined for the function cannot be assumed by Lambda.",
+	}
+
+	testCases := []struct {
+		name     string
+		rule     RetryableErrorRule
+		err      error
+		expected bool
+	}{
+		{
+			name:     "error code",
+			rule:     RetryableErrorRule{ErrorCodes: []string{"ConflictException", "InvalidParameterValue"}},
+			err:      iamPropagationErr,
+			expected: true,
+		},
+		{
+			name:     "error code mismatch",
+			rule:     RetryableErrorRule{ErrorCodes: []string{"ConflictException"}},
+			err:      iamPropagationErr,
+			expected: false,
+		},
+		{
+			name:     "error code and message",
+			rule:     RetryableErrorRule{ErrorCodes: []string{"InvalidParameterValue"}, ErrorMessage: regexache.MustCompile(`cannot be assumed`)},
+			err:      iamPropagationErr,
+			expected: true,
+		},
+		{
+			name:     "error code and message mismatch",
+			rule:     RetryableErrorRule{ErrorCodes: []string{"InvalidParameterValue"}, ErrorMessage: regexache.MustCompile(`does not exist`)},
+			err:      iamPropagationErr,
+			expected: false,
+		},
+		{
+			name:     "message only",
+			rule:     RetryableErrorRule{ErrorMessage: regexache.MustCompile(`connection reset`)},
+			err:      errors.New("read tcp: connection reset by peer"),
+			expected: true,
+		},
+		{
+			name:     "error code not an API error",
+			rule:     RetryableErrorRule{ErrorCodes: []string{"InvalidParameterValue"}},
+			err:      errors.New("InvalidParameterValue"),
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.rule.match(testCase.err), testCase.expected; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryableErrorRulesRetryer(t *testing.T) {
+	t.Parallel()
+
+	matchingErr := &smithy.GenericAPIError{Code: "InvalidParameterValue"}
+	otherErr := &smithy.GenericAPIError{Code: "ValidationException"}
+
+	r := newRetryableErrorRulesRetryer(retry.AddWithMaxAttempts(retry.NewStandard(), 3), []RetryableErrorRule{
+		{
+			ErrorCodes:  []string{"InvalidParameterValue"},
+			MaxAttempts: 10,
+			MaxBackoff:  5 * time.Second,
+		},
+	})
+
+	if !r.IsErrorRetryable(matchingErr) {
+		t.Error("expected matching error to be retryable")
+	}
+
+	if r.IsErrorRetryable(otherErr) {
+		t.Error("expected non-matching error not to be retryable")
+	}
+
+	if got, want := r.MaxAttempts(), 10; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+
+	if delay, err := r.RetryDelay(5, matchingErr); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if delay > 5*time.Second {
+		t.Errorf("RetryDelay = %s, want at most %s", delay, 5*time.Second)
+	}
+
+	var maxAttemptsErr *retry.MaxAttemptsError
+
+	if _, err := r.RetryDelay(10, matchingErr); !errors.As(err, &maxAttemptsErr) {
+		t.Errorf("RetryDelay error = %v, want MaxAttemptsError", err)
+	}
+
+	// Errors not matching any rule keep the API client's maximum number of attempts.
+	throttleErr := &smithy.GenericAPIError{Code: "Throttling"}
+
+	if _, err := r.RetryDelay(2, throttleErr); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if _, err := r.RetryDelay(3, throttleErr); !errors.As(err, &maxAttemptsErr) {
+		t.Errorf("RetryDelay error = %v, want MaxAttemptsError", err)
+	}
+}
+
+func TestWithRetryableErrorRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		Retryer:          func() aws.Retryer { return retry.NewStandard() },
+		RetryMaxAttempts: 5,
+	}
+
+	got := withRetryableErrorRules(cfg, []RetryableErrorRule{{ErrorCodes: []string{"InvalidParameterValue"}, MaxAttempts: 2}})
+
+	if got.RetryMaxAttempts != 0 {
+		t.Errorf("RetryMaxAttempts = %d, want 0", got.RetryMaxAttempts)
+	}
+
+	if cfg.RetryMaxAttempts != 5 {
+		t.Errorf("original RetryMaxAttempts = %d, want 5", cfg.RetryMaxAttempts)
+	}
+
+	if got, want := got.Retryer().MaxAttempts(), 5; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+}
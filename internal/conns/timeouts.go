@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+)
+
+// ResourceTimeouts holds the provider-level default operation timeouts for a resource type.
+// A nil value means that the resource's own default applies.
+type ResourceTimeouts struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// DefaultTimeouts returns any provider-level default operation timeouts for the specified resource type.
+func (c *AWSClient) DefaultTimeouts(_ context.Context, typeName string) (ResourceTimeouts, bool) {
+	v, ok := c.defaultTimeouts[typeName]
+	return v, ok
+}
+
+type (
+	defaultTimeoutsContextKeyType int
+)
+
+var (
+	defaultTimeoutsContextKey defaultTimeoutsContextKeyType
+)
+
+// NewDefaultTimeoutsContext returns a Context carrying the provider-level default operation timeouts
+// for the resource whose CRUD handler is in progress.
+func NewDefaultTimeoutsContext(ctx context.Context, timeouts ResourceTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey, &timeouts)
+}
+
+func DefaultTimeoutsFromContext(ctx context.Context) (*ResourceTimeouts, bool) {
+	v, ok := ctx.Value(defaultTimeoutsContextKey).(*ResourceTimeouts)
+	return v, ok
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingAPIOptions returns AWS SDK for Go v2 API options that create a span for each of the specified service package's API calls.
+// Any in-progress CRUD handler span in Context is the parent span.
+func tracingAPIOptions(tp trace.TracerProvider, servicePackageName string) []func(*middleware.Stack) error {
+	var apiOptions []func(*middleware.Stack) error
+
+	otelaws.AppendMiddlewares(&apiOptions, otelaws.WithTracerProvider(tp))
+
+	return append(apiOptions, func(stack *middleware.Stack) error {
+		// Runs after the OpenTelemetry middleware has started the API call's span.
+		const id = "TerraformTracing"
+		mw := middleware.InitializeMiddlewareFunc(id, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			span := trace.SpanFromContext(ctx)
+			attributes := []attribute.KeyValue{
+				tracing.AttrServicePackage.String(servicePackageName),
+			}
+			if v, ok := CRUDFromContext(ctx); ok {
+				attributes = append(attributes, tracing.AttrResourceType.String(v.TypeName()), tracing.AttrPhase.String(v.Phase()))
+			}
+			span.SetAttributes(attributes...)
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				span.SetAttributes(tracing.AttrRetryCount.Int(len(v.Results) - 1))
+			}
+
+			return out, metadata, err
+		})
+
+		return stack.Initialize.Add(mw, middleware.After)
+	})
+}
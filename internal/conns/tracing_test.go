@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingAPIOptions(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "EC2",
+		Region:        "us-west-2",
+		OperationName: "CreateSubnet",
+	}, middleware.Before); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range tracingAPIOptions(tp, names.EC2) {
+		if err := f(stack); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "aws_subnet.create")
+	ctx = NewCRUDContext(ctx, "aws_subnet", "create")
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("number of spans = %d, want %d", got, want)
+	}
+
+	got := spans[0]
+	if got, want := got.Name, "EC2.CreateSubnet"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+	if got, want := got.SpanKind, trace.SpanKindClient; got != want {
+		t.Errorf("SpanKind = %s, want %s", got, want)
+	}
+	if got, want := got.Parent.SpanID(), parent.SpanContext().SpanID(); got != want {
+		t.Errorf("Parent = %s, want %s", got, want)
+	}
+
+	attributes := attribute.NewSet(got.Attributes...)
+	for k, want := range map[attribute.Key]string{
+		tracing.AttrPhase:          "create",
+		tracing.AttrResourceType:   "aws_subnet",
+		tracing.AttrServicePackage: names.EC2,
+	} {
+		if got, ok := attributes.Value(k); !ok || got.AsString() != want {
+			t.Errorf("attribute %s = %q, want %q", k, got.AsString(), want)
+		}
+	}
+}
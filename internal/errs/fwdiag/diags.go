@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -59,3 +60,20 @@ func DiagnosticsString(diags diag.Diagnostics) string {
 
 	return buf.String()
 }
+
+// FromSDKDiagnostics converts Plugin SDK V2 diagnostics to Plugin Framework diagnostics.
+// Attribute paths are not converted.
+func FromSDKDiagnostics(diags sdkdiag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		switch d.Severity {
+		case sdkdiag.Error:
+			result.AddError(d.Summary, d.Detail)
+		case sdkdiag.Warning:
+			result.AddWarning(d.Summary, d.Detail)
+		}
+	}
+
+	return result
+}
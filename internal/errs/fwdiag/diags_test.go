@@ -6,7 +6,9 @@ package fwdiag_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
 
@@ -68,3 +70,38 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestFromSDKDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		diags    sdkdiag.Diagnostics
+		want     diag.Diagnostics
+	}{
+		{
+			testName: "nil Diagnostics",
+		},
+		{
+			testName: "mixed warning and error Diagnostics",
+			diags: sdkdiag.Diagnostics{
+				{Severity: sdkdiag.Warning, Summary: "summary1", Detail: "detail1"},
+				{Severity: sdkdiag.Error, Summary: "summary2", Detail: "detail2"},
+			},
+			want: diag.Diagnostics{
+				diag.NewWarningDiagnostic("summary1", "detail1"),
+				diag.NewErrorDiagnostic("summary2", "detail2"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(fwdiag.FromSDKDiagnostics(testCase.diags), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
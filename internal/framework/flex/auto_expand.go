@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
 )
 
@@ -487,14 +486,25 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
-		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
-			v, d := s.ValueInterface()
+		//
+		// fwtypes.SmithyJSON -> document.
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValuable); ok {
+			v, d := s.ValueSmithyDocument()
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(v))
+			if v == nil {
+				return diags
+			}
+
+			if vDoc := reflect.ValueOf(v); vDoc.Type().AssignableTo(tTo) {
+				vTo.Set(vDoc)
+			} else {
+				diags.Append(diagCannotBeAssigned(vDoc.Type(), tTo))
+			}
 			return diags
 		}
 
@@ -1152,6 +1162,15 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		opts := flexer.getOptions()
+		if members := opts.unionMemberTypes(valTo.Type()); len(members) > 0 {
+			//
+			// struct -> union interface.
+			//
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
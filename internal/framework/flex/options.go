@@ -3,7 +3,10 @@
 
 package flex
 
-import "slices"
+import (
+	"reflect"
+	"slices"
+)
 
 var (
 	DefaultIgnoredFieldNames = []string{
@@ -27,6 +30,9 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMembers stores the member types of AWS API union types
+	unionMembers []reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +83,36 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnionMembers specifies the member types of one or more AWS API
+// union (Smithy union) types, e.g. `&awstypes.DestinationMemberS3Bucket{}`
+//
+// Use this option to expand union block models to AWS API union types.
+// Each member type is matched to a union block model field by name.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, member := range members {
+			t := reflect.TypeOf(member)
+			if t.Kind() != reflect.Pointer {
+				t = reflect.PointerTo(t)
+			}
+			o.unionMembers = append(o.unionMembers, t)
+		}
+	}
+}
+
+// unionMemberTypes returns the registered member types of the specified union interface type
+func (o *AutoFlexOptions) unionMemberTypes(union reflect.Type) []reflect.Type {
+	var members []reflect.Type
+
+	for _, t := range o.unionMembers {
+		if t.Implements(union) {
+			members = append(members, t)
+		}
+	}
+
+	return members
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
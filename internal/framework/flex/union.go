@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// AWS SDK for Go v2 union (Smithy union) types are interfaces implemented by one struct type per union member.
+// The member types are named `<Union>Member<Name>` and hold the member's value in their `Value` field.
+// For example, the `Destination` union's `S3Bucket` member is `DestinationMemberS3Bucket`.
+//
+// A union maps to a nested block model with one field per union member, matched case-insensitively to the member's `<Name>`.
+// The name in a field's `autoflex` struct tag, e.g. `autoflex:"S3Bucket"`, is used in place of the field name.
+// At most one of the model's fields may be set; the block's schema should validate this, e.g. with objectvalidator.ExactlyOneOf.
+//
+// Go cannot enumerate the types that implement an interface, so expanding a union requires its member types
+// to be passed to Expand using WithUnionMembers. Flattening a union requires no options.
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// expandUnion copies a Plugin Framework union block model to a compatible AWS API union interface value.
+// members holds the union's member types registered using WithUnionMembers.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeTo := valTo.Type()
+
+	var setFieldName string
+	for fromField := range expandSourceFields(ctx, valFrom.Type(), flexer.getOptions()) {
+		fromFieldName := fromField.Name
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+
+		if v, ok := fromFieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if setFieldName != "" {
+			tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; multiple union members set")
+			diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), setFieldName, fromFieldName))
+			return diags
+		}
+		setFieldName = fromFieldName
+
+		memberName := unionMemberFieldName(fromField)
+		member, ok := findUnionMember(typeTo, members, memberName)
+		if !ok {
+			tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; no corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+			})
+			diags.Append(diagExpandingNoUnionMember(typeTo, memberName))
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fromFieldName,
+			logAttrKeyTargetType:      fullTypeName(member),
+		})
+
+		to := reflect.New(member.Elem())
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), fromFieldVal, targetPath, to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+	}
+
+	return diags
+}
+
+// flattenUnion copies an AWS API union interface value to a compatible Plugin Framework union block model.
+func flattenUnion(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to)
+	if valTo.Kind() == reflect.Pointer {
+		valTo = valTo.Elem()
+	}
+
+	member := reflect.Indirect(vFrom.Elem())
+	memberName, ok := strings.CutPrefix(member.Type().Name(), vFrom.Type().Name()+"Member")
+	if !ok || member.Kind() != reflect.Struct {
+		// For example, types.UnknownUnionMember.
+		tflog.SubsystemWarn(ctx, subsystemName, "AutoFlex Flatten; unknown union member", map[string]any{
+			logAttrKeySourceType: fullTypeName(member.Type()),
+		})
+		return diags
+	}
+
+	var found bool
+	for toField := range tfreflect.ExportedStructFields(valTo.Type()) {
+		toFieldName := toField.Name
+		toFieldVal := valTo.FieldByIndex(toField.Index)
+
+		if found || !strings.EqualFold(unionMemberFieldName(toField), memberName) {
+			// All other union members are null.
+			diags.Append(setNullValue(ctx, toFieldVal)...)
+			if diags.HasError() {
+				return diags
+			}
+			continue
+		}
+		found = true
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceType:      fullTypeName(member.Type()),
+			logAttrKeyTargetFieldname: toFieldName,
+		})
+
+		diags.Append(flexer.convert(ctx, sourcePath, member.FieldByName(unionMemberValueFieldName), targetPath.AtName(toFieldName), toFieldVal, fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !found {
+		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; no corresponding union member field", map[string]any{
+			logAttrKeySourceType: fullTypeName(member.Type()),
+		})
+		diags.Append(diagFlatteningNoUnionMemberField(member.Type(), valTo.Type()))
+	}
+
+	return diags
+}
+
+// setNullValue sets a Plugin Framework value to the null value of its type.
+func setNullValue(ctx context.Context, v reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.Interface().(attr.Value)
+	if !ok || !v.CanSet() {
+		return diags
+	}
+
+	typ := value.Type(ctx)
+	null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("creating null %s value: %s", typ, err))
+		return diags
+	}
+
+	if nullVal := reflect.ValueOf(null); nullVal.Type().AssignableTo(v.Type()) {
+		v.Set(nullVal)
+	}
+
+	return diags
+}
+
+// unionMemberFieldName returns the union member name corresponding to a union block model field.
+func unionMemberFieldName(field reflect.StructField) string {
+	if name, _ := autoflexTags(field); name != "" && name != "-" {
+		return name
+	}
+
+	return field.Name
+}
+
+// findUnionMember returns the member type of the specified union interface type with the specified name.
+func findUnionMember(union reflect.Type, members []reflect.Type, name string) (reflect.Type, bool) {
+	prefix := union.Name() + "Member"
+	for _, member := range members {
+		if v, ok := strings.CutPrefix(member.Elem().Name(), prefix); ok && strings.EqualFold(v, name) {
+			return member, true
+		}
+	}
+
+	return nil, false
+}
+
+// isUnionMember returns whether the specified value's dynamic type is a member of the union interface type of the value.
+func isUnionMember(v reflect.Value) bool {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return false
+	}
+
+	member := reflect.Indirect(v.Elem())
+
+	if member.Kind() != reflect.Struct {
+		return false
+	}
+
+	if _, ok := member.Type().FieldByName(unionMemberValueFieldName); !ok {
+		return false
+	}
+
+	return strings.HasPrefix(member.Type().Name(), v.Type().Name()+"Member")
+}
+
+func diagExpandingNoUnionMember(unionType reflect.Type, memberName string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union type %q has no registered member %q.", fullTypeName(unionType), memberName),
+	)
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Configuration",
+		"Only one union member may be configured. "+
+			fmt.Sprintf("Source type %q has both %q and %q set.", fullTypeName(sourceType), fieldName1, fieldName2),
+	)
+}
+
+func diagFlatteningNoUnionMemberField(memberType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Target type %q has no field for union member %q.", fullTypeName(targetType), fullTypeName(memberType)),
+	)
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// testUnion mimics an AWS SDK for Go v2 union type.
+type testUnion interface {
+	isTestUnion()
+}
+
+type testUnionMemberName struct {
+	Value string
+}
+
+func (*testUnionMemberName) isTestUnion() {}
+
+type testUnionMemberS3Bucket struct {
+	Value awsUnionS3Bucket
+}
+
+func (*testUnionMemberS3Bucket) isTestUnion() {}
+
+type testUnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*testUnknownUnionMember) isTestUnion() {}
+
+type awsUnionS3Bucket struct {
+	BucketName *string
+	Prefix     *string
+}
+
+type awsUnion struct {
+	Destination  testUnion
+	Destinations []testUnion
+}
+
+type tfUnionS3Bucket struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Prefix     types.String `tfsdk:"prefix"`
+}
+
+type tfUnionDestination struct {
+	Name types.String                                     `tfsdk:"name"`
+	S3   fwtypes.ListNestedObjectValueOf[tfUnionS3Bucket] `tfsdk:"s3" autoflex:"S3Bucket"`
+}
+
+type tfUnion struct {
+	Destination  fwtypes.ListNestedObjectValueOf[tfUnionDestination] `tfsdk:"destination"`
+	Destinations fwtypes.ListNestedObjectValueOf[tfUnionDestination] `tfsdk:"destinations"`
+}
+
+type awsDocument struct {
+	Policy document.Interface
+}
+
+type tfDocument struct {
+	Policy fwtypes.SmithyJSON[document.Interface] `tfsdk:"policy"`
+}
+
+func testUnionMembers() AutoFlexOptionsFunc {
+	return WithUnionMembers(&testUnionMemberName{}, testUnionMemberS3Bucket{})
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		options    []AutoFlexOptionsFunc
+		source     any
+		wantTarget awsUnion
+		wantError  bool
+	}{
+		"primitive member": {
+			options: []AutoFlexOptionsFunc{testUnionMembers()},
+			source: &tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringValue("example"),
+					S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			wantTarget: awsUnion{
+				Destination: &testUnionMemberName{Value: "example"},
+			},
+		},
+		"struct member": {
+			options: []AutoFlexOptionsFunc{testUnionMembers()},
+			source: &tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringNull(),
+					S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringValue("prefix"),
+					}),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			wantTarget: awsUnion{
+				Destination: &testUnionMemberS3Bucket{Value: awsUnionS3Bucket{
+					BucketName: aws.String("bucket"),
+					Prefix:     aws.String("prefix"),
+				}},
+			},
+		},
+		"list of unions": {
+			options: []AutoFlexOptionsFunc{testUnionMembers()},
+			source: &tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionDestination{
+					{
+						Name: types.StringValue("example"),
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					},
+					{
+						Name: types.StringNull(),
+						S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+							BucketName: types.StringValue("bucket"),
+							Prefix:     types.StringNull(),
+						}),
+					},
+				}),
+			},
+			wantTarget: awsUnion{
+				Destinations: []testUnion{
+					&testUnionMemberName{Value: "example"},
+					&testUnionMemberS3Bucket{Value: awsUnionS3Bucket{
+						BucketName: aws.String("bucket"),
+					}},
+				},
+			},
+		},
+		"multiple members set": {
+			options: []AutoFlexOptionsFunc{testUnionMembers()},
+			source: &tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringValue("example"),
+					S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringNull(),
+					}),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			wantError: true,
+		},
+		"no registered members": {
+			source: &tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringValue("example"),
+					S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			wantTarget: awsUnion{},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			var target awsUnion
+			diags := Expand(ctx, testCase.source, &target, testCase.options...)
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Fatalf("Expand() has error %t, want %t: %v", got, want, diags)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		source     awsUnion
+		wantTarget tfUnion
+	}{
+		"primitive member": {
+			source: awsUnion{
+				Destination: &testUnionMemberName{Value: "example"},
+			},
+			wantTarget: tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringValue("example"),
+					S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"struct member": {
+			source: awsUnion{
+				Destination: &testUnionMemberS3Bucket{Value: awsUnionS3Bucket{
+					BucketName: aws.String("bucket"),
+				}},
+			},
+			wantTarget: tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					Name: types.StringNull(),
+					S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringNull(),
+					}),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"list of unions": {
+			source: awsUnion{
+				Destinations: []testUnion{
+					&testUnionMemberName{Value: "example"},
+					&testUnionMemberS3Bucket{Value: awsUnionS3Bucket{
+						Prefix: aws.String("prefix"),
+					}},
+				},
+			},
+			wantTarget: tfUnion{
+				Destination: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionDestination{
+					{
+						Name: types.StringValue("example"),
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					},
+					{
+						Name: types.StringNull(),
+						S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+							BucketName: types.StringNull(),
+							Prefix:     types.StringValue("prefix"),
+						}),
+					},
+				}),
+			},
+		},
+		"unknown member": {
+			source: awsUnion{
+				Destination: &testUnknownUnionMember{Tag: "New", Value: []byte("{}")},
+			},
+			wantTarget: tfUnion{
+				Destination:  fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			var target tfUnion
+			diags := Flatten(ctx, &testCase.source, &target)
+
+			if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const policy = `{"Rules":[{"ResourceType":"collection"}]}`
+
+	tf := tfDocument{
+		Policy: fwtypes.SmithyJSONValue(policy, document.NewLazyDocument),
+	}
+
+	var api awsDocument
+	if diags := Expand(ctx, tf, &api); diags.HasError() {
+		t.Fatalf("Expand: %v", diags)
+	}
+
+	if api.Policy == nil {
+		t.Fatal("Expand: document not set")
+	}
+
+	var got tfDocument
+	if diags := Flatten(ctx, &api, &got); diags.HasError() {
+		t.Fatalf("Flatten: %v", diags)
+	}
+
+	if equal, diags := got.Policy.StringSemanticEquals(ctx, tf.Policy); diags.HasError() || !equal {
+		t.Errorf("Flatten: got %s, want %s (%v)", got.Policy.ValueString(), policy, diags)
+	}
+
+	var null awsDocument
+	if diags := Expand(ctx, tfDocument{Policy: fwtypes.SmithyJSONNull[document.Interface]()}, &null); diags.HasError() {
+		t.Fatalf("Expand null: %v", diags)
+	}
+
+	if diff := cmp.Diff(null, awsDocument{}, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Expand null: unexpected diff (+wanted, -got): %s", diff)
+	}
+}
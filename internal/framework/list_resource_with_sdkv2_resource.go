@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is embedded in list resources that list instances of a Plugin SDK V2 resource.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resource *schema.Resource
+}
+
+// Metadata should return the full name of the list resource, such as
+// examplecloud_thing.
+func (*ListResourceWithSDKv2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method is implemented in the wrappers.
+	panic("not implemented") // lintignore:R009
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetSDKv2Resource sets the listed Plugin SDK V2 resource.
+// The resource must be the one registered with the provider so that its schema, identity and interceptors are used.
+func (l *ListResourceWithSDKv2Resource) SetSDKv2Resource(r *schema.Resource) {
+	l.resource = r
+}
+
+// SDKv2Resource returns the listed Plugin SDK V2 resource.
+func (l *ListResourceWithSDKv2Resource) SDKv2Resource() *schema.Resource {
+	return l.resource
+}
+
+// SetResult reads the resource instance with the specified ID and sets the list result's identity and,
+// if requested, the resource instance's state.
+// The resource's Read handler is used so that the list result matches the state of an imported resource.
+// Returns false if the resource instance no longer exists.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, includeResource bool, id string, result *list.ListResult) bool {
+	c := l.Meta()
+	d := l.resource.Data(nil)
+	d.SetId(id)
+
+	if _, ok := l.resource.SchemaMap()[names.AttrRegion]; ok {
+		if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("listing %s", id), err.Error())
+			return true
+		}
+	}
+
+	diags := l.resource.ReadWithoutTimeout(ctx, d, c)
+	result.Diagnostics.Append(fwdiag.FromSDKDiagnostics(diags)...)
+	if diags.HasError() {
+		return true
+	}
+
+	// Not found.
+	if d.Id() == "" {
+		return false
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError(fmt.Sprintf("listing %s", id), err.Error())
+		return true
+	}
+	result.Identity.Raw = *identity
+
+	if includeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("listing %s", id), err.Error())
+			return true
+		}
+		result.Resource.Raw = *state
+	} else {
+		result.Resource = nil
+	}
+
+	return true
+}
+
+// ListResultError returns a list result that reports an error encountered while listing resources.
+func ListResultError(summary string, err error) list.ListResult {
+	var diags diag.Diagnostics
+	diags.AddError(summary, err.Error())
+
+	return list.ListResult{
+		Diagnostics: diags,
+	}
+}
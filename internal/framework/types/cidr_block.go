@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -87,8 +88,9 @@ func (cidrBlockType) ValueType(context.Context) attr.Value {
 }
 
 var (
-	_ basetypes.StringValuable    = (*CIDRBlock)(nil)
-	_ xattr.ValidateableAttribute = (*CIDRBlock)(nil)
+	_ basetypes.StringValuable       = (*CIDRBlock)(nil)
+	_ xattr.ValidateableAttribute    = (*CIDRBlock)(nil)
+	_ function.ValidateableParameter = (*CIDRBlock)(nil)
 )
 
 func CIDRBlockNull() CIDRBlock {
@@ -136,3 +138,18 @@ func (v CIDRBlock) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 		)
 	}
 }
+
+func (v CIDRBlock) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := itypes.ValidateCIDRBlock(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid CIDR Block Value: "+
+				"The provided value failed validation.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
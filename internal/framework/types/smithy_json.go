@@ -98,8 +98,15 @@ var (
 	_ basetypes.StringValuable                   = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 	_ basetypes.StringValuableWithSemanticEquals = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 	_ xattr.ValidateableAttribute                = (*SmithyJSON[smithyjson.JSONStringer])(nil)
+	_ SmithyDocumentValuable                     = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 )
 
+// SmithyDocumentValuable is implemented by SmithyJSON values of any Smithy document type.
+type SmithyDocumentValuable interface {
+	basetypes.StringValuable
+	ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics)
+}
+
 type SmithyJSON[T smithyjson.JSONStringer] struct {
 	basetypes.StringValue
 	f func(any) T
@@ -139,6 +146,15 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 	return v.f(data), diags
 }
 
+// ValueSmithyDocument returns the value as a Smithy document, or nil if the value is null or unknown.
+func (v SmithyJSON[T]) ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	return v.ValueInterface()
+}
+
 func (v SmithyJSON[T]) Type(context.Context) attr.Type {
 	return SmithyJSONType[T]{}
 }
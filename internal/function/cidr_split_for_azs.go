@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// Subnet CIDR block size limits.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+	subnetIPv4CIDRBlockMaxPrefixLength = 28
+	subnetIPv6CIDRBlockMaxPrefixLength = 64
+)
+
+var _ function.Function = cidrSplitForAZsFunction{}
+
+func NewCIDRSplitForAZsFunction() function.Function {
+	return &cidrSplitForAZsFunction{}
+}
+
+type cidrSplitForAZsFunction struct{}
+
+func (f cidrSplitForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_split_for_azs"
+}
+
+func (f cidrSplitForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_split_for_azs Function",
+		MarkdownDescription: "Splits a VPC CIDR block into equally sized, non-overlapping subnet CIDR blocks, one per Availability Zone. " +
+			"The subnet CIDR blocks are as large as possible and must be valid AWS subnet sizes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				CustomType:          fwtypes.CIDRBlockType,
+				Name:                "cidr_block",
+				MarkdownDescription: "VPC IPv4 or IPv6 CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSplitForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azCount int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azCount))
+	if resp.Error != nil {
+		return
+	}
+
+	if azCount < 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "az_count must be at least 1"))
+		return
+	}
+
+	result, err := itypes.SplitCIDRBlock(cidr, int(azCount))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	if err := validateSubnetCIDRBlockSize(result[0]); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// validateSubnetCIDRBlockSize validates that the specified CIDR block is not smaller than the smallest AWS subnet.
+func validateSubnetCIDRBlockSize(cidr string) error {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return err
+	}
+
+	maxPrefixLength := subnetIPv4CIDRBlockMaxPrefixLength
+	if prefix.Addr().Is6() {
+		maxPrefixLength = subnetIPv6CIDRBlockMaxPrefixLength
+	}
+
+	if prefix.Bits() > maxPrefixLength {
+		return fmt.Errorf("subnet CIDR block (%s) is smaller than the minimum subnet size (/%d)", cidr, maxPrefixLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSplitForAZsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSplitForAZsFunctionConfig("10.0.0.0/16", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/18,10.0.64.0/18,10.0.128.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("10.0.0.0/27", 2),
+				ExpectError: regexache.MustCompile(`smaller[\s\n]*than[\s\n]*the[\s\n]*minimum[\s\n]*subnet[\s\n]*size`),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitForAZsFunction_invalidAZCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitForAZsFunctionConfig("10.0.0.0/16", 0),
+				ExpectError: regexache.MustCompile(`az_count[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*least[\s\n]*1`),
+			},
+		},
+	})
+}
+
+func testCIDRSplitForAZsFunctionConfig(cidr string, azCount int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_split_for_azs(%[1]q, %[2]d))
+}
+`, cidr, azCount)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyDocument is a minimal representation of an IAM policy document.
+// Statements are retained verbatim so that no policy content is lost when
+// documents are merged or normalized.
+type iamPolicyDocument struct {
+	Version    string                `json:",omitempty"`
+	ID         string                `json:"Id,omitempty"`
+	Statements iamPolicyStatementSet `json:"Statement,omitempty"`
+}
+
+type iamPolicyStatement map[string]any
+
+func (s iamPolicyStatement) sid() string {
+	if v, ok := s["Sid"].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+type iamPolicyStatementSet []iamPolicyStatement
+
+// UnmarshalJSON accepts either a single statement object or an array of statements.
+func (ss *iamPolicyStatementSet) UnmarshalJSON(b []byte) error {
+	if b := bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var s iamPolicyStatement
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+
+		*ss = iamPolicyStatementSet{s}
+
+		return nil
+	}
+
+	var s []iamPolicyStatement
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*ss = s
+
+	return nil
+}
+
+// merge merges another policy document into this one.
+// The semantics match those of the aws_iam_policy_document data source's override_policy_documents argument:
+// statements with a non-empty Sid replace any existing statement with the same Sid.
+func (doc *iamPolicyDocument) merge(other *iamPolicyDocument) {
+	if other.ID != "" {
+		doc.ID = other.ID
+	}
+
+	if other.Version > doc.Version {
+		doc.Version = other.Version
+	}
+
+	for _, statement := range other.Statements {
+		sid := statement.sid()
+		if sid == "" {
+			doc.Statements = append(doc.Statements, statement)
+			continue
+		}
+
+		var seen bool
+		for i, existing := range doc.Statements {
+			if existing.sid() == sid {
+				doc.Statements[i] = statement
+				seen = true
+				break
+			}
+		}
+
+		if !seen {
+			doc.Statements = append(doc.Statements, statement)
+		}
+	}
+}
+
+func parseIAMPolicyDocument(policy string) (*iamPolicyDocument, error) {
+	var doc iamPolicyDocument
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid: %w", policy, err)
+	}
+
+	return &doc, nil
+}
+
+// normalizeIAMPolicyDocument returns the policy document's normalized JSON representation.
+func normalizeIAMPolicyDocument(doc *iamPolicyDocument) (string, error) {
+	if doc == nil {
+		return "", errors.New("policy document is empty")
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.PolicyToSet("", string(b))
+}
+
+// normalizeIAMPolicyStatement returns the policy statement's normalized JSON representation.
+func normalizeIAMPolicyStatement(statement iamPolicyStatement) (string, error) {
+	b, err := json.Marshal(statement)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
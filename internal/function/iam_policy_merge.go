@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document. " +
+			"Statements with a non-empty `Sid` replace any statement with the same `Sid` from a preceding document.",
+		VariadicParameter: function.StringParameter{
+			CustomType:          fwtypes.IAMPolicyType,
+			Name:                "policies",
+			MarkdownDescription: "IAM policy documents (JSON) to merge",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies merges the specified policy documents in order and returns the normalized result.
+func mergePolicies(policies []string) (string, error) {
+	if len(policies) == 0 {
+		return "", errors.New("at least one policy document must be specified")
+	}
+
+	var merged *iamPolicyDocument
+	for _, policy := range policies {
+		doc, err := parseIAMPolicyDocument(policy)
+		if err != nil {
+			return "", err
+		}
+
+		if merged == nil {
+			merged = doc
+		} else {
+			merged.merge(doc)
+		}
+	}
+
+	return normalizeIAMPolicyDocument(merged)
+}
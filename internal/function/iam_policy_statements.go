@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ function.Function = iamPolicyStatementsFunction{}
+
+func NewIAMPolicyStatementsFunction() function.Function {
+	return &iamPolicyStatementsFunction{}
+}
+
+type iamPolicyStatementsFunction struct{}
+
+func (f iamPolicyStatementsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_statements"
+}
+
+func (f iamPolicyStatementsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_statements Function",
+		MarkdownDescription: "Returns the statements of an IAM policy document as a list of normalized JSON strings",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				CustomType:          fwtypes.IAMPolicyType,
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f iamPolicyStatementsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parseIAMPolicyDocument(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make([]string, 0, len(doc.Statements))
+	for _, statement := range doc.Statements {
+		v, err := normalizeIAMPolicyStatement(statement)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+
+		result = append(result, v)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyStatementsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	arg := `{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"ec2:DescribeInstances","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyStatementsFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("first", `{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"S3"}`),
+				),
+			},
+		},
+	})
+}
+
+func testIAMPolicyStatementsFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  statements = provider::aws::iam_policy_statements(%[1]q)
+}
+
+output "count" {
+  value = length(local.statements)
+}
+
+output "first" {
+  value = local.statements[0]
+}
+`, arg)
+}
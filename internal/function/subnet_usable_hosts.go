@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = subnetUsableHostsFunction{}
+
+func NewSubnetUsableHostsFunction() function.Function {
+	return &subnetUsableHostsFunction{}
+}
+
+type subnetUsableHostsFunction struct{}
+
+func (f subnetUsableHostsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_usable_hosts"
+}
+
+func (f subnetUsableHostsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_usable_hosts Function",
+		MarkdownDescription: "Returns the number of usable host IP addresses in a subnet CIDR block. " +
+			"The first four IP addresses and the last IP address in each subnet CIDR block are reserved by AWS.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				CustomType:          fwtypes.CIDRBlockType,
+				Name:                "cidr_block",
+				MarkdownDescription: "Subnet IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f subnetUsableHostsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	n, err := itypes.SubnetUsableIPAddressCount(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, new(big.Float).SetInt(n)))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge Function",
+		MarkdownDescription: "Merges default tags with resource tags and removes ignored tags, " +
+			"returning the value the provider computes for a resource's `tags_all` attribute. " +
+			"Resource tags override default tags with the same key.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				ElementType:         types.StringType,
+				Name:                "default_tags",
+				MarkdownDescription: "Default tags, as configured in the provider's `default_tags` block",
+			},
+			function.MapParameter{
+				ElementType:         types.StringType,
+				Name:                "tags",
+				MarkdownDescription: "Resource tags",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "ignore_keys",
+				MarkdownDescription: "Tag keys to ignore, as configured in the provider's `ignore_tags` block",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "ignore_key_prefixes",
+				MarkdownDescription: "Tag key prefixes to ignore, as configured in the provider's `ignore_tags` block",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaultTags, tags map[string]string
+	var ignoreKeys, ignoreKeyPrefixes []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &defaultTags, &tags, &ignoreKeys, &ignoreKeyPrefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, defaultTags),
+	}
+	ignoreTagsConfig := &tftags.IgnoreConfig{
+		Keys:        tftags.New(ctx, ignoreKeys),
+		KeyPrefixes: tftags.New(ctx, ignoreKeyPrefixes),
+	}
+
+	result := defaultTagsConfig.MergeTags(tftags.New(ctx, tags)).IgnoreConfig(ignoreTagsConfig)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Map()))
+}
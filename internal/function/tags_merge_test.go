@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("environment", "production"),
+					resource.TestCheckOutput("owner", "platform"),
+					resource.TestCheckOutput("name", "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_ignoreKeys(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_ignoreKeys,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("name", "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_ignoreKeyPrefixes(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_ignoreKeyPrefixes,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput("name", "example"),
+				),
+			},
+		},
+	})
+}
+
+const testTagsMergeFunctionConfig_basic = `
+locals {
+  tags_all = provider::aws::tags_merge(
+    { Environment = "staging", Owner = "platform" },
+    { Environment = "production", Name = "example" },
+    [],
+    [],
+  )
+}
+
+output "count" {
+  value = length(local.tags_all)
+}
+
+output "environment" {
+  value = local.tags_all["Environment"]
+}
+
+output "owner" {
+  value = local.tags_all["Owner"]
+}
+
+output "name" {
+  value = local.tags_all["Name"]
+}
+`
+
+const testTagsMergeFunctionConfig_ignoreKeyPrefixes = `
+locals {
+  tags_all = provider::aws::tags_merge(
+    { "ops:Owner" = "platform" },
+    { "ops:Environment" = "production", Name = "example" },
+    [],
+    ["ops:"],
+  )
+}
+
+output "count" {
+  value = length(local.tags_all)
+}
+
+output "name" {
+  value = local.tags_all["Name"]
+}
+`
+
+const testTagsMergeFunctionConfig_ignoreKeys = `
+locals {
+  tags_all = provider::aws::tags_merge(
+    { Owner = "platform", CreatedBy = "automation" },
+    { LastModified = "2024-01-01", Name = "example" },
+    ["CreatedBy", "LastModified"],
+    [],
+  )
+}
+
+output "count" {
+  value = length(local.tags_all)
+}
+
+output "name" {
+  value = local.tags_all["Name"]
+}
+`
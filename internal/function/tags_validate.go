@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = tagsValidateFunction{}
+
+func NewTagsValidateFunction() function.Function {
+	return &tagsValidateFunction{}
+}
+
+type tagsValidateFunction struct{}
+
+func (f tagsValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_validate"
+}
+
+func (f tagsValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_validate Function",
+		MarkdownDescription: "Validates resource tags against a service's tag limits and the system tag keys reserved by the service. " +
+			"Returns a list of validation error messages, which is empty if the tags are valid.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				ElementType:         types.StringType,
+				Name:                "tags",
+				MarkdownDescription: "Resource tags",
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service package name or alias, for example `ec2` or `elasticbeanstalk`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var service string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &service))
+	if resp.Error != nil {
+		return
+	}
+
+	serviceName, err := servicePackageName(service)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	limits, err := names.ServiceTagLimits(serviceName)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, validateTags(tftags.New(ctx, tags), serviceName, limits)))
+}
+
+// servicePackageName returns the provider service package name for the specified service package name or alias.
+func servicePackageName(service string) (string, error) {
+	if _, err := names.ProviderNameUpper(service); err == nil {
+		return service, nil
+	}
+
+	return names.ProviderPackageForAlias(service)
+}
+
+// validateTags returns messages describing any tags that violate the specified service's tag limits
+// or use system tag keys reserved by the service.
+func validateTags(tags tftags.KeyValueTags, serviceName string, limits names.TagLimits) []string {
+	messages := make([]string, 0)
+
+	if n := len(tags); n > limits.MaxCount {
+		messages = append(messages, fmt.Sprintf("too many tags (%d), maximum is %d", n, limits.MaxCount))
+	}
+
+	userTags := tags.IgnoreSystem(serviceName)
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if _, ok := userTags[key]; !ok {
+			messages = append(messages, fmt.Sprintf("tag key (%s) is reserved for system use", key))
+			continue
+		}
+
+		if n := utf8.RuneCountInString(key); n < 1 || n > limits.KeyMaxLength {
+			messages = append(messages, fmt.Sprintf("tag key (%s) must be between 1 and %d characters in length", key, limits.KeyMaxLength))
+		}
+
+		if n := utf8.RuneCountInString(tags.KeyTagData(key).ValueString()); n > limits.ValueMaxLength {
+			messages = append(messages, fmt.Sprintf("tag (%s) value must be at most %d characters in length", key, limits.ValueMaxLength))
+		}
+	}
+
+	return messages
+}
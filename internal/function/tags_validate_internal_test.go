@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	manyTags := make(map[string]string)
+	for i := range 51 {
+		manyTags[strings.Repeat("k", i+1)] = "v"
+	}
+	elevenTags := make(map[string]string)
+	for i := range 11 {
+		elevenTags[strings.Repeat("k", i+1)] = "v"
+	}
+
+	for name, tc := range map[string]struct {
+		tags        map[string]string
+		serviceName string
+		count       int
+	}{
+		"valid": {
+			tags:        map[string]string{"Name": "example", "Environment": ""},
+			serviceName: names.EC2,
+			count:       0,
+		},
+		"AWS prefix": {
+			tags:        map[string]string{"aws:key": "example"},
+			serviceName: names.EC2,
+			count:       1,
+		},
+		"Elastic Beanstalk Name": {
+			tags:        map[string]string{"Name": "example", "elasticbeanstalk:key": "example"},
+			serviceName: names.ElasticBeanstalk,
+			count:       2,
+		},
+		"value too long": {
+			tags:        map[string]string{"Name": strings.Repeat("v", 257)},
+			serviceName: names.EC2,
+			count:       1,
+		},
+		"too many": {
+			tags:        manyTags,
+			serviceName: names.EC2,
+			count:       1,
+		},
+		"Route 53 too many": {
+			tags:        elevenTags,
+			serviceName: names.Route53,
+			count:       1,
+		},
+		"EC2 eleven": {
+			tags:        elevenTags,
+			serviceName: names.EC2,
+			count:       0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limits, err := names.ServiceTagLimits(tc.serviceName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := len(validateTags(tftags.New(ctx, tc.tags), tc.serviceName, limits)), tc.count; got != want {
+				t.Errorf("validateTags() returned %d messages, want %d", got, want)
+			}
+		})
+	}
+}
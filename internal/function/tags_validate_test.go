@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsValidateFunctionConfig(`{ Name = "example" }`, "ec2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsValidateFunction_reservedKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsValidateFunctionConfig(`{ Name = "example", "aws:cloudformation:stack-name" = "example" }`, "ec2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+				),
+			},
+			{
+				Config: testTagsValidateFunctionConfig(`{ Name = "example" }`, "elasticbeanstalk"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsValidateFunction_invalidService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsValidateFunctionConfig(`{ Name = "example" }`, "invalid"),
+				ExpectError: regexache.MustCompile(`unable[\s\n]*to[\s\n]*find[\s\n]*service`),
+			},
+		},
+	})
+}
+
+func testTagsValidateFunctionConfig(tags, service string) string {
+	return fmt.Sprintf(`
+output "count" {
+  value = length(provider::aws::tags_validate(%[1]s, %[2]q))
+}
+`, tags, service)
+}
@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -58,10 +59,13 @@ func main() {
 		v := &visitor{
 			g: g,
 
+			isGlobal: l.IsGlobal(),
+
 			ephemeralResources:   make(map[string]ResourceDatum, 0),
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
@@ -81,6 +85,7 @@ func main() {
 			FrameworkDataSources:    v.frameworkDataSources,
 			FrameworkResources:      v.frameworkResources,
 			SDKDataSources:          v.sdkDataSources,
+			SDKListResources:        v.sdkListResources,
 			SDKResources:            v.sdkResources,
 		}
 		templateFuncMap := template.FuncMap{
@@ -120,6 +125,22 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IsGlobal                bool
+	RegionOverrideEnabled   bool
+	ARNIdentity             bool
+	ARNAttribute            string
+	SingletonIdentity       bool
+	IdentityAttributes      []IdentityAttributeDatum
+}
+
+// HasIdentity returns whether the resource has a resource identity.
+func (d ResourceDatum) HasIdentity() bool {
+	return d.ARNIdentity || d.SingletonIdentity || len(d.IdentityAttributes) > 0
+}
+
+type IdentityAttributeDatum struct {
+	Name     string
+	Required bool
 }
 
 type ServiceDatum struct {
@@ -132,6 +153,7 @@ type ServiceDatum struct {
 	FrameworkDataSources    map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
+	SDKListResources        map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 }
 
@@ -151,6 +173,8 @@ type visitor struct {
 	errs []error
 	g    *common.Generator
 
+	isGlobal bool
+
 	fileName     string
 	functionName string
 	packageName  string
@@ -159,6 +183,7 @@ type visitor struct {
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -201,12 +226,73 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
-	d := ResourceDatum{}
+	// Look first for tagging, Region and identity annotations.
+	d := ResourceDatum{
+		IsGlobal:              v.isGlobal,
+		RegionOverrideEnabled: !v.isGlobal,
+	}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsGlobal = global
+					d.RegionOverrideEnabled = !global
+				}
+			}
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if enabled, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionOverrideEnabled = enabled
+				}
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			args := common.ParseArgs(m[3])
+
+			switch m[1] {
+			case "ArnIdentity":
+				d.ARNIdentity = true
+				d.ARNAttribute = namesgen.ConstOrQuote(names.AttrARN)
+				if len(args.Positional) > 0 {
+					d.ARNAttribute = namesgen.ConstOrQuote(args.Positional[0])
+				}
+			case "IdentityAttribute":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				identityAttribute := IdentityAttributeDatum{
+					Name:     namesgen.ConstOrQuote(args.Positional[0]),
+					Required: true,
+				}
+
+				if attr, ok := args.Keyword["optional"]; ok {
+					optional, err := strconv.ParseBool(attr)
+					if err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid IdentityAttribute/optional value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					}
+
+					identityAttribute.Required = !optional
+				}
+
+				d.IdentityAttributes = append(d.IdentityAttributes, identityAttribute)
+			case "SingletonIdentity":
+				d.SingletonIdentity = true
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -226,6 +312,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	if d.ARNIdentity && (d.SingletonIdentity || len(d.IdentityAttributes) > 0) || d.SingletonIdentity && len(d.IdentityAttributes) > 0 {
+		v.errs = append(v.errs, fmt.Errorf("multiple identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -331,6 +421,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -354,7 +467,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "Region", "SingletonIdentity", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
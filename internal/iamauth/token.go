@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iamauth
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	// SHA-256 hash of an empty request payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// BuildConnectToken returns an IAM authentication token for a connection to the specified host.
+// The token is a "connect" request with the specified query parameters, presigned with SigV4 for the specified
+// signing name and Region, without its URL scheme.
+func BuildConnectToken(ctx context.Context, host string, query url.Values, signingName, region string, credentials aws.Credentials, lifetime time.Duration, signingTime time.Time) (string, error) {
+	values := url.Values{
+		"Action":        []string{"connect"},
+		"X-Amz-Expires": []string{strconv.FormatInt(int64(lifetime/time.Second), 10)},
+	}
+	maps.Copy(values, query)
+
+	// The scheme is arbitrary and is removed from the presigned URL.
+	const scheme = "http://"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+host+"/?"+values.Encode(), nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, signingName, region, signingTime)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, scheme), nil
+}
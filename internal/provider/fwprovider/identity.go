@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the Plugin Framework identity schema for the specified resource identity.
+func newIdentitySchema(identity itypes.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(identity.Attributes))
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: v.Required,
+			OptionalForImport: !v.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// validateIdentityAttributes ensures that all the resource identity's parameter attributes are defined in the resource's schema.
+func validateIdentityAttributes(ctx context.Context, identity itypes.ServicePackageResourceIdentity, inner resource.Resource) error {
+	schemaResponse := resource.SchemaResponse{}
+	inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	for _, v := range identity.ParameterAttributes() {
+		if _, ok := schemaResponse.Schema.Attributes[v.Name]; !ok {
+			return fmt.Errorf("identity attribute `%s` is not defined", v.Name)
+		}
+	}
+
+	return nil
+}
+
+// importByIdentity sets the resource's identifying attributes in state and returns the resource's ID
+// when the resource is imported by identity rather than by import ID.
+func importByIdentity(ctx context.Context, c *conns.AWSClient, identity itypes.ServicePackageResourceIdentity, isRegionOverrideEnabled bool, request resource.ImportStateRequest, response *resource.ImportStateResponse) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string, len(identity.Attributes))
+	for _, v := range identity.Attributes {
+		var value types.String
+		diags.Append(request.Identity.GetAttribute(ctx, path.Root(v.Name), &value)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		values[v.Name] = value.ValueString()
+	}
+
+	id, region, err := interceptors.ImportIDFromIdentity(identity, values, c.AccountID(ctx), c.ProviderRegion(ctx))
+	if err != nil {
+		diags.AddError("Importing by identity", err.Error())
+		return "", diags
+	}
+
+	if region != "" {
+		if !isRegionOverrideEnabled {
+			diags.AddError("Importing by identity", fmt.Sprintf("Region (%s) does not match provider Region (%s)", region, c.ProviderRegion(ctx)))
+			return "", diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	for _, v := range identity.ParameterAttributes() {
+		name := v.Name
+		if v := values[name]; v != "" {
+			diags.Append(response.State.SetAttribute(ctx, path.Root(name), v)...)
+			if diags.HasError() {
+				return "", diags
+			}
+		}
+	}
+
+	return id, diags
+}
+
+// identityInterceptor sets the resource's identity after Create, Read and Update.
+type identityInterceptor struct {
+	identity itypes.ServicePackageResourceIdentity
+}
+
+func newIdentityInterceptor(identity itypes.ServicePackageResourceIdentity) resourceInterceptor {
+	return &identityInterceptor{
+		identity: identity,
+	}
+}
+
+func (r identityInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.setIdentity(ctx, opts.c, &response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		diags.Append(r.setIdentity(ctx, opts.c, &response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.setIdentity(ctx, opts.c, &response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+// setIdentity sets the resource's identity attributes from the resource's state.
+func (r identityInterceptor) setIdentity(ctx context.Context, c *conns.AWSClient, state *tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	for _, v := range r.identity.Attributes {
+		var value types.String
+
+		switch name := v.Name; {
+		case name == names.AttrAccountID && !r.identity.IsARN:
+			value = types.StringValue(c.AccountID(ctx))
+		case name == names.AttrRegion && !r.identity.IsARN:
+			value = types.StringValue(c.Region(ctx))
+		default:
+			diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(v.Name), value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type interceptorOptions[Request, Response any] struct {
@@ -141,8 +144,19 @@ type interceptedResponse interface {
 
 // interceptedHandler returns a handler that runs any interceptors.
 func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, c *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
-	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
-		var diags diag.Diagnostics
+	return func(ctx context.Context, request Request, response *Response) (diags diag.Diagnostics) {
+		if v, ok := conns.CRUDFromContext(ctx); ok {
+			var servicePackageName string
+			if v, ok := conns.FromContext(ctx); ok {
+				servicePackageName = v.ServicePackageName()
+			}
+			var span trace.Span
+			ctx, span = tracing.StartCRUDSpan(ctx, servicePackageName, v.TypeName(), v.Phase())
+			defer func() {
+				tracing.EndSpan(span, fwdiag.DiagnosticsError(diags))
+			}()
+		}
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -8,17 +8,21 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -31,17 +35,18 @@ import (
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
-func New(primary interface{ Meta() any }) provider.Provider {
+func New(primary *sdkschema.Provider) provider.Provider {
 	return &fwprovider{
 		Primary: primary,
 	}
 }
 
 type fwprovider struct {
-	Primary interface{ Meta() any }
+	Primary *sdkschema.Provider
 }
 
 func (*fwprovider) Metadata(ctx context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
@@ -61,10 +66,28 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_partitions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS partitions, e.g. `aws`. The provider's Region and any per-resource Region override must be in one of these partitions.",
+			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions. The provider's Region and any per-resource Region override must be one of these Regions.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines record is appended for each AWS API call. Request and response payloads are not recorded.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"dry_run_report_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines record of each mutating AWS API call is appended instead of the call being made. Can also be configured using the `" + conns.DryRunReportPathEnvVar + "` environment variable.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -77,6 +100,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"forbidden_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of forbidden AWS Regions. The provider's Region and any per-resource Region override must not be one of these Regions.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -261,6 +289,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"propagate_to_children": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to apply resources' tags to the child resources they create implicitly.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -270,6 +302,33 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for a resource type. Timeouts configured in a resource's `timeouts` block take precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Create timeout, e.g. `60m`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Delete timeout, e.g. `60m`.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Read timeout, e.g. `60m`.",
+						},
+						names.AttrResourceType: schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type, e.g. `aws_db_instance`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Update timeout, e.g. `60m`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -293,6 +352,130 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API requests made to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The maximum number of requests that can be made at once. Defaults to `tokens_per_second` rounded up.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block.",
+						},
+						"tokens_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum sustained number of requests per second for all of the service's API operations without an override. Reduced while the service returns throttling errors.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"operation": schema.ListNestedBlock{
+							Description: "Configuration blocks with rate limits overriding the service's rate limit for individual API operations.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"burst": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+										Description: "The maximum number of requests that can be made at once. Defaults to `tokens_per_second` rounded up.",
+									},
+									names.AttrName: schema.StringAttribute{
+										Required:    true,
+										Description: "The name of the API operation, e.g. `ChangeResourceRecordSets`.",
+									},
+									"tokens_per_second": schema.Float64Attribute{
+										Required:    true,
+										Description: "The maximum sustained number of requests per second. Reduced while the service returns throttling errors.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retryable_errors": schema.ListNestedBlock{
+				Description: "Configuration blocks with additional retryable error conditions for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "API error codes to retry.",
+						},
+						"error_message": schema.StringAttribute{
+							Optional:    true,
+							Description: "Regular expression matching error messages to retry.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The maximum number of attempts for a matching error. Defaults to `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum delay between attempts for a matching error, e.g. `30s`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that the tags of taggable resources must comply with.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement_mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(tftags.PolicyEnforcementModes()...),
+							},
+							Description: "How tag policy violations are reported. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"policy_document": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS Organizations tag policy document.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Configuration blocks with tag rules.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "The allowed tag values. A value ending in `*` allows any value with that prefix.",
+									},
+									"enforce_key_case": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag key must match `key` exactly.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag is required.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -306,6 +489,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -333,6 +517,17 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			typeName := v.TypeName
 			interceptors := dataSourceInterceptors{}
+			if v.Region.IsOverrideEnabled {
+				// The data source supports per-resource Region override.
+				// Ensure that the schema doesn't already define the attribute.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+			}
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -354,10 +549,22 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if v.Region.IsOverrideEnabled {
+						overrideRegion, diags = getOverrideRegion(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+						diags.Append(verifyOverrideRegion(ctx, c, overrideRegion)...)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -366,8 +573,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 					return ctx, diags
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:            interceptors,
+				isRegionOverrideEnabled: v.Region.IsOverrideEnabled,
+				typeName:                typeName,
 			}
 			dataSources = append(dataSources, func() datasource.DataSource {
 				return newWrappedDataSource(inner, opts)
@@ -406,6 +614,29 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			typeName := v.TypeName
 			var modifyPlanFuncs []modifyPlanFunc
 			interceptors := resourceInterceptors{}
+			if v.Region.IsOverrideEnabled {
+				// The resource supports per-resource Region override.
+				// Ensure that the schema doesn't already define the attribute.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				modifyPlanFuncs = append(modifyPlanFuncs, setRegion)
+			}
+			if v.Identity.IsEnabled() {
+				// The resource has a resource identity.
+				// Ensure that the identity's attributes are defined in the schema.
+				if err := validateIdentityAttributes(ctx, v.Identity, inner); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, newIdentityInterceptor(v.Identity))
+			}
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -431,16 +662,28 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					continue
 				}
 
-				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll)
+				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll, verifyTagPolicy)
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if v.Region.IsOverrideEnabled {
+						overrideRegion, diags = getOverrideRegion(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+						diags.Append(verifyOverrideRegion(ctx, c, overrideRegion)...)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -449,9 +692,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 					return ctx, diags
 				},
-				interceptors:    interceptors,
-				modifyPlanFuncs: modifyPlanFuncs,
-				typeName:        typeName,
+				identity:                v.Identity,
+				interceptors:            interceptors,
+				isRegionOverrideEnabled: v.Region.IsOverrideEnabled,
+				modifyPlanFuncs:         modifyPlanFuncs,
+				typeName:                typeName,
 			}
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(inner, opts)
@@ -492,13 +737,37 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					continue
 				}
 
+				if v.Region.IsOverrideEnabled {
+					// The ephemeral resource supports per-resource Region override.
+					// Ensure that the schema doesn't already define the attribute.
+					schemaResponse := ephemeral.SchemaResponse{}
+					inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, v.TypeName))
+						continue
+					}
+				}
+
 				interceptors := ephemeralResourceInterceptors{}
 				opts := wrappedEphemeralResourceOptions{
 					// bootstrapContext is run on all wrapped methods before any interceptors.
-					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if v.Region.IsOverrideEnabled {
+							overrideRegion, diags = getOverrideRegion(ctx, getAttribute)
+							if diags.HasError() {
+								return ctx, diags
+							}
+							diags.Append(verifyOverrideRegion(ctx, c, overrideRegion)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+						}
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
@@ -506,8 +775,9 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 						}
 						return ctx, diags
 					},
-					interceptors: interceptors,
-					typeName:     v.TypeName,
+					interceptors:            interceptors,
+					isRegionOverrideEnabled: v.Region.IsOverrideEnabled,
+					typeName:                v.TypeName,
 				}
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
 					return newWrappedEphemeralResource(inner, opts)
@@ -525,6 +795,107 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+// Each list resource's type name must match that of a Plugin SDK V2 resource registered with the primary provider.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.SDKListResources(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating list resource", map[string]any{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				typeName := v.TypeName
+				r, ok := p.Primary.ResourcesMap[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("no resource registered: %s", typeName))
+					continue
+				}
+				if r.Identity == nil {
+					errs = append(errs, fmt.Errorf("resource has no identity: %s", typeName))
+					continue
+				}
+
+				if v, ok := inner.(interface{ SetSDKv2Resource(*sdkschema.Resource) }); ok {
+					v.SetSDKv2Resource(r)
+				} else {
+					errs = append(errs, fmt.Errorf("list resource does not list a Plugin SDK V2 resource: %s", typeName))
+					continue
+				}
+
+				if v.Region.IsOverrideEnabled {
+					// The list resource supports per-resource Region override.
+					// Ensure that the schema doesn't already define the attribute.
+					schemaResponse := list.ListResourceSchemaResponse{}
+					inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+						continue
+					}
+				}
+
+				opts := wrappedListResourceOptions{
+					// bootstrapContext is run on all wrapped methods before any interceptors.
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if v.Region.IsOverrideEnabled {
+							overrideRegion, diags = getOverrideRegion(ctx, getAttribute)
+							if diags.HasError() {
+								return ctx, diags
+							}
+							diags.Append(verifyOverrideRegion(ctx, c, overrideRegion)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+						}
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+						if c != nil {
+							ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+							ctx = c.RegisterLogger(ctx)
+							ctx = flex.RegisterLogger(ctx)
+						}
+
+						return ctx, diags
+					},
+					isRegionOverrideEnabled: v.Region.IsOverrideEnabled,
+					resource:                r,
+					typeName:                typeName,
+				}
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -534,6 +905,15 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSplitForAZsFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIAMPolicyStatementsFunction,
+		tffunction.NewSubnetUsableHostsFunction,
+		tffunction.NewTagsMergeFunction,
+		tffunction.NewTagsValidateFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,616 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+func regionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(verify.RegionRegexp, "must be a valid AWS Region Code"),
+	}
+}
+
+// regionDataSourceSchemaAttribute returns the schema for the top-level `region` attribute injected into data sources.
+func regionDataSourceSchemaAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators:  regionValidators(),
+	}
+}
+
+// regionEphemeralResourceSchemaAttribute returns the schema for the top-level `region` attribute injected into ephemeral resources.
+func regionEphemeralResourceSchemaAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators:  regionValidators(),
+	}
+}
+
+// regionListResourceSchemaAttribute returns the schema for the top-level `region` attribute injected into list resources.
+func regionListResourceSchemaAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Region in which to list resources. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators(),
+	}
+}
+
+// regionResourceSchemaAttribute returns the schema for the top-level `region` attribute injected into resources.
+func regionResourceSchemaAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators:  regionValidators(),
+	}
+}
+
+// getOverrideRegion returns the value of any per-resource Region override.
+func getOverrideRegion(ctx context.Context, getAttribute getAttributeFunc) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if getAttribute == nil {
+		return "", diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return region.ValueString(), diags
+}
+
+// verifyOverrideRegion returns an error diagnostic if the provider configuration does not permit
+// operating in the per-resource Region override.
+func verifyOverrideRegion(ctx context.Context, c *conns.AWSClient, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if region == "" || c == nil {
+		return diags
+	}
+
+	if err := c.VerifyRegionAllowed(ctx, region); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Region Not Allowed", err.Error())
+	}
+
+	return diags
+}
+
+// setRegion is a plan modifier that sets the planned value of the top-level `region` attribute to the provider's
+// configured Region if no per-resource override is configured and requires replacement if the Region changes.
+func setRegion(ctx context.Context, c *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	planRegion := configRegion
+	if configRegion.IsNull() {
+		planRegion = types.StringValue(c.ProviderRegion(ctx))
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// New resource.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var stateRegion types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created before per-resource Region override was supported have no Region in state.
+	if stateRegion.IsNull() && planRegion.ValueString() == c.ProviderRegion(ctx) {
+		return
+	}
+
+	if !planRegion.IsUnknown() && !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+}
+
+// regionProjector projects Plugin Framework request and response data between the wrapper's schema,
+// which defines the top-level `region` attribute, and the inner schema, which does not.
+// The first error encountered is retained and all subsequent projections are no-ops.
+type regionProjector struct {
+	err error
+}
+
+// remove returns a copy of the specified object value with the top-level `region` attribute removed.
+func (p *regionProjector) remove(v tftypes.Value) tftypes.Value {
+	if p.err != nil {
+		return v
+	}
+
+	v, p.err = removeRegionAttribute(v)
+
+	return v
+}
+
+// add returns a copy of the specified object value with the top-level `region` attribute set.
+func (p *regionProjector) add(v tftypes.Value, region tftypes.Value) tftypes.Value {
+	if p.err != nil {
+		return v
+	}
+
+	v, p.err = addRegionAttribute(v, region)
+
+	return v
+}
+
+// diagnostics returns any projection error as Diagnostics.
+func (p *regionProjector) diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if p.err != nil {
+		diags.AddError("Projecting Region Attribute", p.err.Error())
+	}
+
+	return diags
+}
+
+func removeRegionAttribute(v tftypes.Value) (tftypes.Value, error) {
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		// Zero value.
+		return v, nil
+	}
+
+	attributeTypes := maps.Clone(typ.AttributeTypes)
+	delete(attributeTypes, names.AttrRegion)
+	newType := tftypes.Object{AttributeTypes: attributeTypes}
+
+	switch {
+	case v.IsNull():
+		return tftypes.NewValue(newType, nil), nil
+	case !v.IsKnown():
+		return tftypes.NewValue(newType, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, fmt.Errorf("removing %s attribute: %w", names.AttrRegion, err)
+	}
+	delete(attributes, names.AttrRegion)
+
+	return tftypes.NewValue(newType, attributes), nil
+}
+
+func addRegionAttribute(v tftypes.Value, region tftypes.Value) (tftypes.Value, error) {
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		// Zero value.
+		return v, nil
+	}
+
+	attributeTypes := maps.Clone(typ.AttributeTypes)
+	attributeTypes[names.AttrRegion] = tftypes.String
+	newType := tftypes.Object{AttributeTypes: attributeTypes}
+
+	switch {
+	case v.IsNull():
+		return tftypes.NewValue(newType, nil), nil
+	case !v.IsKnown():
+		return tftypes.NewValue(newType, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, fmt.Errorf("adding %s attribute: %w", names.AttrRegion, err)
+	}
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(newType, attributes), nil
+}
+
+// getRegionAttribute returns the value of the top-level `region` attribute of the specified object value.
+func getRegionAttribute(v tftypes.Value) tftypes.Value {
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if !v.IsKnown() || v.IsNull() {
+		return null
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return null
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		return v
+	}
+
+	return null
+}
+
+func regionValue(ctx context.Context, c *conns.AWSClient) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, c.Region(ctx))
+}
+
+func (w *wrappedDataSource) innerSchema(ctx context.Context) (datasourceschema.Schema, diag.Diagnostics) {
+	var response datasource.SchemaResponse
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+	return response.Schema, response.Diagnostics
+}
+
+func (w *wrappedDataSource) readWithRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	state := response.State
+	state.Raw = p.add(innerResponse.State.Raw, regionValue(ctx, w.meta))
+	*response = innerResponse
+	response.State = state
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedDataSource) validateConfigWithRegion(ctx context.Context, v datasource.DataSourceWithValidateConfig, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var p regionProjector
+	innerRequest := request
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.ValidateConfig(ctx, innerRequest, response)
+}
+
+func (w *wrappedEphemeralResource) innerSchema(ctx context.Context) (ephemeralschema.Schema, diag.Diagnostics) {
+	var response ephemeral.SchemaResponse
+	w.inner.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+	return response.Schema, response.Diagnostics
+}
+
+func (w *wrappedEphemeralResource) openWithRegion(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	innerResponse.Result.Raw, innerResponse.Result.Schema = p.remove(response.Result.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Open(ctx, innerRequest, &innerResponse)
+
+	result := response.Result
+	result.Raw = p.add(innerResponse.Result.Raw, regionValue(ctx, w.meta))
+	*response = innerResponse
+	response.Result = result
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedEphemeralResource) validateConfigWithRegion(ctx context.Context, v ephemeral.EphemeralResourceWithValidateConfig, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var p regionProjector
+	innerRequest := request
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.ValidateConfig(ctx, innerRequest, response)
+}
+
+func (w *wrappedResource) innerSchema(ctx context.Context) (resourceschema.Schema, diag.Diagnostics) {
+	var response resource.SchemaResponse
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+	return response.Schema, response.Diagnostics
+}
+
+func (w *wrappedResource) createWithRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	innerRequest.Plan.Raw, innerRequest.Plan.Schema = p.remove(request.Plan.Raw), s
+	innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	state := response.State
+	state.Raw = p.add(innerResponse.State.Raw, regionValue(ctx, w.meta))
+	*response = innerResponse
+	response.State = state
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) readWithRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.State.Raw, innerRequest.State.Schema = p.remove(request.State.Raw), s
+	innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	state := response.State
+	state.Raw = p.add(innerResponse.State.Raw, regionValue(ctx, w.meta))
+	*response = innerResponse
+	response.State = state
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) updateWithRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	innerRequest.Plan.Raw, innerRequest.Plan.Schema = p.remove(request.Plan.Raw), s
+	innerRequest.State.Raw, innerRequest.State.Schema = p.remove(request.State.Raw), s
+	innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	state := response.State
+	state.Raw = p.add(innerResponse.State.Raw, regionValue(ctx, w.meta))
+	*response = innerResponse
+	response.State = state
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) deleteWithRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.State.Raw, innerRequest.State.Schema = p.remove(request.State.Raw), s
+	innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	state := response.State
+	state.Raw = p.add(innerResponse.State.Raw, getRegionAttribute(request.State.Raw))
+	*response = innerResponse
+	response.State = state
+	response.Diagnostics.Append(p.diagnostics()...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) modifyPlanWithRegion(ctx context.Context, v resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var p regionProjector
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	innerRequest.Plan.Raw, innerRequest.Plan.Schema = p.remove(request.Plan.Raw), s
+	innerRequest.State.Raw, innerRequest.State.Schema = p.remove(request.State.Raw), s
+	innerResponse.Plan.Raw, innerResponse.Plan.Schema = p.remove(response.Plan.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	plan := response.Plan
+	plan.Raw = p.add(innerResponse.Plan.Raw, getRegionAttribute(response.Plan.Raw))
+	*response = innerResponse
+	response.Plan = plan
+	response.Diagnostics.Append(p.diagnostics()...)
+}
+
+func (w *wrappedResource) validateConfigWithRegion(ctx context.Context, v resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	s, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var p regionProjector
+	innerRequest := request
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	response.Diagnostics.Append(p.diagnostics()...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.ValidateConfig(ctx, innerRequest, response)
+}
+
+// stateUpgraderWithRegion wraps a state upgrader so that it sees the inner schema.
+// The upgraded state has a null Region which is set on the subsequent Read.
+func (w *wrappedResource) stateUpgraderWithRegion(f func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse)) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		s, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var p regionProjector
+		innerResponse := *response
+		innerResponse.State.Raw, innerResponse.State.Schema = p.remove(response.State.Raw), s
+		response.Diagnostics.Append(p.diagnostics()...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		f(ctx, request, &innerResponse)
+
+		state := response.State
+		state.Raw = p.add(innerResponse.State.Raw, tftypes.NewValue(tftypes.String, nil))
+		*response = innerResponse
+		response.State = state
+		response.Diagnostics.Append(p.diagnostics()...)
+	}
+}
+
+// stateMoverWithRegion wraps a state mover so that it sees the inner schema.
+// The moved state has a null Region which is set on the subsequent Read.
+func (w *wrappedResource) stateMoverWithRegion(f func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse)) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+		s, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var p regionProjector
+		innerResponse := *response
+		innerResponse.TargetState.Raw, innerResponse.TargetState.Schema = p.remove(response.TargetState.Raw), s
+		response.Diagnostics.Append(p.diagnostics()...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		f(ctx, request, &innerResponse)
+
+		state := response.TargetState
+		state.Raw = p.add(innerResponse.TargetState.Raw, tftypes.NewValue(tftypes.String, nil))
+		*response = innerResponse
+		response.TargetState = state
+		response.Diagnostics.Append(p.diagnostics()...)
+	}
+}
+
+func (w *wrappedListResource) innerSchema(ctx context.Context) (listschema.Schema, diag.Diagnostics) {
+	var response list.ListResourceSchemaResponse
+	w.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &response)
+	return response.Schema, response.Diagnostics
+}
+
+func (w *wrappedListResource) listWithRegion(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	s, diags := w.innerSchema(ctx)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var p regionProjector
+	innerRequest := request
+	innerRequest.Config.Raw, innerRequest.Config.Schema = p.remove(request.Config.Raw), s
+	if diags := p.diagnostics(); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	w.inner.List(ctx, innerRequest, stream)
+}
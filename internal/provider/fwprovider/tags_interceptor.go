@@ -292,3 +292,39 @@ func setTagsAll(ctx context.Context, meta *conns.AWSClient, request resource.Mod
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 	}
 }
+
+// verifyTagPolicy is a plan modifier that checks the planned value of the `tags_all` attribute against any provider configured tag_policy.
+func verifyTagPolicy(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	tagPolicy := meta.TagPolicyConfig(ctx)
+	if tagPolicy == nil {
+		return
+	}
+
+	var allTags tftags.Map
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &allTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !allTags.IsWhollyKnown() {
+		return
+	}
+
+	for _, v := range tagPolicy.Evaluate(tftags.New(ctx, allTags)) {
+		attributePath := path.Root(names.AttrTagsAll)
+		if v.Key != "" {
+			attributePath = attributePath.AtMapKey(v.Key)
+		}
+
+		if tagPolicy.WarnOnly() {
+			response.Diagnostics.AddAttributeWarning(attributePath, "Tag Policy Violation", v.Message)
+		} else {
+			response.Diagnostics.AddAttributeError(attributePath, "Tag Policy Violation", v.Message)
+		}
+	}
+}
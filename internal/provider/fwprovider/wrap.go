@@ -5,15 +5,26 @@ package fwprovider
 
 import (
 	"context"
+	"iter"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Implemented by (Config|Plan|State).GetAttribute().
@@ -22,11 +33,23 @@ type getAttributeFunc func(context.Context, path.Path, any) diag.Diagnostics
 // contextFunc augments Context.
 type contextFunc func(context.Context, getAttributeFunc, *conns.AWSClient) (context.Context, diag.Diagnostics)
 
+// withAttribute returns a copy of the specified schema attributes with the named attribute added.
+func withAttribute[T any](attributes map[string]T, name string, attribute T) map[string]T {
+	attributes = maps.Clone(attributes)
+	if attributes == nil {
+		attributes = make(map[string]T)
+	}
+	attributes[name] = attribute
+
+	return attributes
+}
+
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	interceptors     dataSourceInterceptors
-	typeName         string
+	bootstrapContext        contextFunc
+	interceptors            dataSourceInterceptors
+	isRegionOverrideEnabled bool
+	typeName                string
 }
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
@@ -56,6 +79,10 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled {
+		response.Schema.Attributes = withAttribute(response.Schema.Attributes, names.AttrRegion, datasourceschema.Attribute(regionDataSourceSchemaAttribute()))
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -65,7 +92,12 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "read")
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.readWithRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -112,15 +144,21 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			w.validateConfigWithRegion(ctx, v, request, response)
+			return
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
 type wrappedEphemeralResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	interceptors     ephemeralResourceInterceptors
-	typeName         string
+	bootstrapContext        contextFunc
+	interceptors            ephemeralResourceInterceptors
+	isRegionOverrideEnabled bool
+	typeName                string
 }
 
 // wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
@@ -150,6 +188,10 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled {
+		response.Schema.Attributes = withAttribute(response.Schema.Attributes, names.AttrRegion, ephemeralschema.Attribute(regionEphemeralResourceSchemaAttribute()))
+	}
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
@@ -159,7 +201,12 @@ func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.O
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "open")
 	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.openWithRegion(ctx, request, response)
+		}
+
 		w.inner.Open(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -188,6 +235,7 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 			return
 		}
 
+		ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "renew")
 		f := func(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) diag.Diagnostics {
 			v.Renew(ctx, request, response)
 			return response.Diagnostics
@@ -204,6 +252,7 @@ func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.
 			return
 		}
 
+		ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "close")
 		f := func(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) diag.Diagnostics {
 			v.Close(ctx, request, response)
 			return response.Diagnostics
@@ -238,6 +287,11 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			w.validateConfigWithRegion(ctx, v, request, response)
+			return
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -247,10 +301,12 @@ type modifyPlanFunc func(context.Context, *conns.AWSClient, resource.ModifyPlanR
 
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	interceptors     resourceInterceptors
-	modifyPlanFuncs  []modifyPlanFunc
-	typeName         string
+	bootstrapContext        contextFunc
+	identity                itypes.ServicePackageResourceIdentity
+	interceptors            resourceInterceptors
+	isRegionOverrideEnabled bool
+	modifyPlanFuncs         []modifyPlanFunc
+	typeName                string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
@@ -261,10 +317,18 @@ type wrappedResource struct {
 }
 
 func newWrappedResource(inner resource.ResourceWithConfigure, opts wrappedResourceOptions) resource.ResourceWithConfigure {
-	return &wrappedResource{
+	w := &wrappedResource{
 		inner: inner,
 		opts:  opts,
 	}
+
+	if opts.identity.IsEnabled() {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -280,6 +344,10 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled {
+		response.Schema.Attributes = withAttribute(response.Schema.Attributes, names.AttrRegion, resourceschema.Attribute(regionResourceSchemaAttribute()))
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -289,7 +357,13 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "create")
+	ctx = w.withDefaultTimeouts(ctx)
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.createWithRegion(ctx, request, response)
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -303,7 +377,13 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "read")
+	ctx = w.withDefaultTimeouts(ctx)
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.readWithRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -317,7 +397,13 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "update")
+	ctx = w.withDefaultTimeouts(ctx)
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.updateWithRegion(ctx, request, response)
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -331,13 +417,32 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
+	ctx = conns.NewCRUDContext(ctx, w.opts.typeName, "delete")
+	ctx = w.withDefaultTimeouts(ctx)
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.opts.isRegionOverrideEnabled {
+			return w.deleteWithRegion(ctx, request, response)
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.delete(), f, w.meta)(ctx, request, response)...)
 }
 
+// withDefaultTimeouts adds any provider-level default operation timeouts for the resource type to the Context.
+func (w *wrappedResource) withDefaultTimeouts(ctx context.Context) context.Context {
+	if w.meta == nil {
+		return ctx
+	}
+
+	if v, ok := w.meta.DefaultTimeouts(ctx, w.opts.typeName); ok {
+		ctx = conns.NewDefaultTimeoutsContext(ctx, v)
+	}
+
+	return ctx
+}
+
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
@@ -354,7 +459,31 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		var getAttribute getAttributeFunc
+		if request.ID == "" && request.Identity != nil && w.opts.identity.IsEnabled() {
+			// Imported by identity.
+			id, diags := importByIdentity(ctx, w.meta, w.opts.identity, w.opts.isRegionOverrideEnabled, request, response)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			request.ID = id
+			getAttribute = response.State.GetAttribute
+		} else if w.opts.isRegionOverrideEnabled {
+			// The import ID can specify a per-resource Region override.
+			if id, region, ok := interceptors.ParseImportIDRegion(request.ID); ok {
+				request.ID = id
+				response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				getAttribute = response.State.GetAttribute
+			}
+		}
+
+		ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
@@ -386,6 +515,11 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	}
 
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		if w.opts.isRegionOverrideEnabled {
+			w.modifyPlanWithRegion(ctx, v, request, response)
+			return
+		}
+
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -416,6 +550,11 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			w.validateConfigWithRegion(ctx, v, request, response)
+			return
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -432,7 +571,15 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 			return nil
 		}
 
-		return v.UpgradeState(ctx)
+		stateUpgraders := v.UpgradeState(ctx)
+		if w.opts.isRegionOverrideEnabled {
+			for k, stateUpgrader := range stateUpgraders {
+				stateUpgrader.StateUpgrader = w.stateUpgraderWithRegion(stateUpgrader.StateUpgrader)
+				stateUpgraders[k] = stateUpgrader
+			}
+		}
+
+		return stateUpgraders
 	}
 
 	return nil
@@ -450,8 +597,127 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 			return nil
 		}
 
-		return v.MoveState(ctx)
+		stateMovers := v.MoveState(ctx)
+		if w.opts.isRegionOverrideEnabled {
+			for i, stateMover := range stateMovers {
+				stateMovers[i].StateMover = w.stateMoverWithRegion(stateMover.StateMover)
+			}
+		}
+
+		return stateMovers
 	}
 
 	return nil
 }
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	// This method does not call down to the inner resource.
+	response.IdentitySchema = newIdentitySchema(w.opts.identity)
+}
+
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext        contextFunc
+	isRegionOverrideEnabled bool
+	// resource is the listed Plugin SDK V2 resource, as registered with the primary provider.
+	resource *sdkschema.Resource
+	typeName string
+}
+
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource
+// that lists instances of a Plugin SDK V2 resource.
+type wrappedListResource struct {
+	inner list.ListResourceWithConfigure
+	meta  *conns.AWSClient
+	opts  wrappedListResourceOptions
+}
+
+func newWrappedListResource(inner list.ListResourceWithConfigure, opts wrappedListResourceOptions) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method does not call down to the inner list resource.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled {
+		response.Schema.Attributes = withAttribute(response.Schema.Attributes, names.AttrRegion, listschema.Attribute(regionListResourceSchemaAttribute()))
+	}
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if w.opts.isRegionOverrideEnabled {
+		w.listWithRegion(ctx, request, stream)
+	} else {
+		w.inner.List(ctx, request, stream)
+	}
+
+	stream.Results = limitListResults(stream.Results, request.Limit)
+}
+
+// limitListResults stops the specified list results stream once the requested number of results have been pushed.
+// Results that only report diagnostics do not count towards the limit.
+func limitListResults(results iter.Seq[list.ListResult], limit int64) iter.Seq[list.ListResult] {
+	if results == nil || limit <= 0 {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		var n int64
+		for result := range results {
+			if result.Identity != nil {
+				n++
+			}
+
+			if !yield(result) || n >= limit {
+				return
+			}
+		}
+	}
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+// RawV5Schemas returns the listed Plugin SDK V2 resource's schema and identity schema.
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = w.opts.resource.ProtoSchema(ctx)()
+	if f := w.opts.resource.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newResourceIdentity returns the Plugin SDK v2 identity schema for the specified resource identity.
+func newResourceIdentity(identity types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(identity.Attributes))
+			for _, v := range identity.Attributes {
+				s[v.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: v.Required,
+					OptionalForImport: !v.Required,
+				}
+			}
+			return s
+		},
+	}
+}
+
+// validateIdentityAttributes ensures that all the resource identity's parameter attributes are defined in the resource's schema.
+func validateIdentityAttributes(identity types.ServicePackageResourceIdentity, s map[string]*schema.Schema) error {
+	for _, v := range identity.ParameterAttributes() {
+		if v.Name == names.AttrID {
+			continue
+		}
+
+		if _, ok := s[v.Name]; !ok {
+			return fmt.Errorf("identity attribute `%s` is not defined", v.Name)
+		}
+	}
+
+	return nil
+}
+
+// identityData is implemented by schema.ResourceData.
+type identityData interface {
+	Identity() (*schema.IdentityData, error)
+}
+
+// importIdentity returns an importFunc that sets the resource's ID and identifying attributes
+// when the resource is imported by identity rather than by import ID.
+func importIdentity(identity types.ServicePackageResourceIdentity, isRegionOverrideEnabled bool) importFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) error {
+		// Imported by ID.
+		if d.Id() != "" {
+			return nil
+		}
+
+		data, err := d.Identity()
+		if err != nil {
+			return fmt.Errorf("reading identity: %w", err)
+		}
+
+		values := make(map[string]string, len(identity.Attributes))
+		for _, v := range identity.Attributes {
+			name := v.Name
+			if v, ok := data.GetOk(name); ok {
+				values[name] = v.(string)
+			}
+		}
+
+		c := meta.(*conns.AWSClient)
+		id, region, err := interceptors.ImportIDFromIdentity(identity, values, c.AccountID(ctx), c.ProviderRegion(ctx))
+		if err != nil {
+			return fmt.Errorf("importing by identity: %w", err)
+		}
+
+		if region != "" {
+			if !isRegionOverrideEnabled {
+				return fmt.Errorf("importing by identity: Region (%s) does not match provider Region (%s)", region, c.ProviderRegion(ctx))
+			}
+
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+
+		for _, v := range identity.ParameterAttributes() {
+			if v.Name == names.AttrID {
+				continue
+			}
+
+			name := v.Name
+			if v := values[name]; v != "" {
+				if err := d.Set(name, v); err != nil {
+					return fmt.Errorf("setting %s: %w", name, err)
+				}
+			}
+		}
+
+		d.SetId(id)
+
+		return nil
+	}
+}
+
+// identityInterceptor sets the resource's identity after Create, Read and Update.
+func identityInterceptor(identity types.ServicePackageResourceIdentity) interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+				if d.Id() == "" {
+					return diags
+				}
+
+				fallthrough
+			case Create, Update:
+				v, ok := d.(identityData)
+				if !ok {
+					return diags
+				}
+
+				data, err := v.Identity()
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading identity: %s", err)
+				}
+
+				for _, v := range identity.Attributes {
+					var value any
+
+					switch name := v.Name; {
+					case name == names.AttrAccountID && !identity.IsARN:
+						value = opts.c.AccountID(ctx)
+					case name == names.AttrRegion && !identity.IsARN:
+						value = opts.c.Region(ctx)
+					case name == names.AttrID:
+						value = d.Id()
+					default:
+						value = d.Get(name)
+					}
+
+					if err := data.Set(v.Name, value); err != nil {
+						return sdkdiag.AppendErrorf(diags, "setting identity attribute %s: %s", v.Name, err)
+					}
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	ctx := context.Background()
+	client := &conns.AWSClient{}
+	conns.SetAccountID(client, accountID)
+	conns.SetRegion(client, region)
+
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrBucket: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		names.AttrRegion: regionSchema(),
+	}
+
+	testCases := map[string]struct {
+		identity                types.ServicePackageResourceIdentity
+		isRegionOverrideEnabled bool
+		raw                     map[string]string
+		wantID                  string
+		wantBucket              string
+		wantRegion              string
+		wantErr                 bool
+	}{
+		"parameterized": {
+			identity:                types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			isRegionOverrideEnabled: true,
+			raw: map[string]string{
+				names.AttrBucket: "example",
+			},
+			wantID:     "example",
+			wantBucket: "example",
+		},
+		"parameterized region override": {
+			identity:                types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			isRegionOverrideEnabled: true,
+			raw: map[string]string{
+				names.AttrBucket: "example",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantID:     "example",
+			wantBucket: "example",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"parameterized region override disabled": {
+			identity: types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			raw: map[string]string{
+				names.AttrBucket: "example",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantErr: true,
+		},
+		"parameterized other account": {
+			identity:                types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			isRegionOverrideEnabled: true,
+			raw: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrBucket:    "example",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataWithIdentityRaw(t, resourceSchema, newResourceIdentity(testCase.identity).SchemaMap(), testCase.raw)
+
+			err := importIdentity(testCase.identity, testCase.isRegionOverrideEnabled)(ctx, d, client)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("importIdentity() err = %v, want error = %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := d.Id(), testCase.wantID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrBucket).(string), testCase.wantBucket; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrBucket, got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.wantRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateIdentityAttributes(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	if err := validateIdentityAttributes(types.GlobalParameterizedIdentity(types.StringIdentityAttribute(names.AttrName, true)), resourceSchema); err != nil {
+		t.Errorf("validateIdentityAttributes() err = %v, want nil", err)
+	}
+
+	if err := validateIdentityAttributes(types.GlobalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)), resourceSchema); err == nil {
+		t.Error("validateIdentityAttributes() err = nil, want error")
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 // schemaResourceData is an interface that implements a subset of schema.ResourceData's public methods.
@@ -71,6 +73,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// String returns the name of a single CRUD operation, e.g. "create".
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -81,12 +99,22 @@ func (s interceptorItems) why(why why) interceptorItems {
 }
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx, diags := bootstrapContext(ctx, d.GetOk, meta)
+func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, typeName string, why why) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
+		ctx, diags = bootstrapContext(ctx, d.GetOk, meta)
 		if diags.HasError() {
 			return diags
 		}
+		ctx = conns.NewCRUDContext(ctx, typeName, why.String())
+
+		var servicePackageName string
+		if v, ok := conns.FromContext(ctx); ok {
+			servicePackageName = v.ServicePackageName()
+		}
+		ctx, span := tracing.StartCRUDSpan(ctx, servicePackageName, typeName, why.String())
+		defer func() {
+			tracing.EndSpan(span, sdkdiag.DiagnosticsError(diags))
+		}()
 
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
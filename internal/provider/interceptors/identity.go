@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ImportIDFromIdentity returns the resource ID for a resource imported by identity.
+// Any account ID in the identity must match the provider's account ID.
+// If the identity specifies a Region other than the provider's Region, that Region is returned as `overrideRegion`.
+func ImportIDFromIdentity(identity types.ServicePackageResourceIdentity, values map[string]string, accountID, region string) (id, overrideRegion string, err error) {
+	if identity.IsARN {
+		v := values[identity.IDAttribute]
+		arn, err := arn.Parse(v)
+		if err != nil {
+			return "", "", fmt.Errorf("identity attribute %q: %w", identity.IDAttribute, err)
+		}
+
+		if arn.AccountID != "" && arn.AccountID != accountID {
+			return "", "", fmt.Errorf("identity attribute %q: account ID (%s) does not match provider account ID (%s)", identity.IDAttribute, arn.AccountID, accountID)
+		}
+
+		if !identity.IsGlobalResource && arn.Region != region {
+			overrideRegion = arn.Region
+		}
+
+		return v, overrideRegion, nil
+	}
+
+	if v := values[names.AttrAccountID]; v != "" && v != accountID {
+		return "", "", fmt.Errorf("identity attribute %q: account ID (%s) does not match provider account ID (%s)", names.AttrAccountID, v, accountID)
+	}
+
+	if !identity.IsGlobalResource {
+		if v := values[names.AttrRegion]; v != "" && v != region {
+			overrideRegion = v
+			region = v
+		}
+	}
+
+	if identity.IsSingleton {
+		if identity.IsGlobalResource {
+			return accountID, overrideRegion, nil
+		}
+
+		return region, overrideRegion, nil
+	}
+
+	if v := identity.IDAttribute; v != "" {
+		id = values[v]
+		if id == "" {
+			return "", "", fmt.Errorf("identity attribute %q is required", v)
+		}
+
+		return id, overrideRegion, nil
+	}
+
+	var parts []string
+	for _, v := range identity.ParameterAttributes() {
+		parts = append(parts, values[v.Name])
+	}
+
+	id, err = flex.FlattenResourceId(parts, len(parts), false)
+	if err != nil {
+		return "", "", err
+	}
+
+	return id, overrideRegion, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestImportIDFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := map[string]struct {
+		identity           types.ServicePackageResourceIdentity
+		values             map[string]string
+		wantID             string
+		wantOverrideRegion string
+		wantErr            bool
+	}{
+		"regional ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			},
+			wantID: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+		},
+		"regional ARN other region": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:sns:eu-west-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			},
+			wantID:             "arn:aws:sns:eu-west-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			wantOverrideRegion: "eu-west-1",                                  //lintignore:AWSAT003
+		},
+		"regional ARN other account": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:sns:us-west-2:210987654321:example", //lintignore:AWSAT003,AWSAT005
+			},
+			wantErr: true,
+		},
+		"invalid ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "example",
+			},
+			wantErr: true,
+		},
+		"global ARN": {
+			identity: types.GlobalARNIdentity(names.AttrARN),
+			values: map[string]string{
+				names.AttrARN: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			},
+			wantID: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		"regional single parameter": {
+			identity: types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			values: map[string]string{
+				names.AttrBucket: "example",
+			},
+			wantID: "example",
+		},
+		"regional single parameter account ID and region": {
+			identity: types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			values: map[string]string{
+				names.AttrAccountID: accountID,
+				names.AttrRegion:    "eu-west-1", //lintignore:AWSAT003
+				names.AttrBucket:    "example",
+			},
+			wantID:             "example",
+			wantOverrideRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"regional single parameter other account": {
+			identity: types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			values: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrBucket:    "example",
+			},
+			wantErr: true,
+		},
+		"regional single parameter missing": {
+			identity: types.RegionalParameterizedIdentity(types.StringIdentityAttribute(names.AttrBucket, true)),
+			values:   map[string]string{},
+			wantErr:  true,
+		},
+		"global multiple parameters": {
+			identity: types.GlobalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrRole, true),
+				types.StringIdentityAttribute("policy_arn", true),
+			),
+			values: map[string]string{
+				names.AttrRole: "example",
+				"policy_arn":   "arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+			},
+			wantID: "example,arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+		},
+		"global multiple parameters missing": {
+			identity: types.GlobalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrRole, true),
+				types.StringIdentityAttribute("policy_arn", true),
+			),
+			values: map[string]string{
+				names.AttrRole: "example",
+			},
+			wantErr: true,
+		},
+		"regional singleton": {
+			identity: types.RegionalSingletonIdentity(),
+			values:   map[string]string{},
+			wantID:   region,
+		},
+		"regional singleton other region": {
+			identity: types.RegionalSingletonIdentity(),
+			values: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantID:             "eu-west-1", //lintignore:AWSAT003
+			wantOverrideRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"global singleton": {
+			identity: types.GlobalSingletonIdentity(),
+			values:   map[string]string{},
+			wantID:   accountID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotOverrideRegion, err := ImportIDFromIdentity(testCase.identity, testCase.values, accountID, region)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ImportIDFromIdentity() err = %v, want error = %t", err, want)
+			}
+			if got, want := gotID, testCase.wantID; got != want {
+				t.Errorf("ImportIDFromIdentity() id = %q, want %q", got, want)
+			}
+			if got, want := gotOverrideRegion, testCase.wantOverrideRegion; got != want {
+				t.Errorf("ImportIDFromIdentity() overrideRegion = %q, want %q", got, want)
+			}
+		})
+	}
+}
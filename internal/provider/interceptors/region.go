@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// importIDRegionSeparator separates a resource's import ID from any per-resource Region override.
+	importIDRegionSeparator = "@"
+)
+
+// ParseImportIDRegion splits an import ID of the form `<id>@<region>` into its ID and Region parts.
+// If the import ID has no valid Region suffix the import ID is returned unchanged and `ok` is false.
+func ParseImportIDRegion(importID string) (id, region string, ok bool) {
+	i := strings.LastIndex(importID, importIDRegionSeparator)
+	if i < 0 {
+		return importID, "", false
+	}
+
+	if id, region = importID[:i], importID[i+len(importIDRegionSeparator):]; id == "" || !verify.RegionRegexp.MatchString(region) {
+		return importID, "", false
+	}
+
+	return id, region, true
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"testing"
+)
+
+func TestParseImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID   string
+		wantID     string
+		wantRegion string
+		wantOK     bool
+	}{
+		"no region": {
+			importID: "vpc-12345678",
+			wantID:   "vpc-12345678",
+		},
+		"region": {
+			importID:   "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			wantID:     "vpc-12345678",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+			wantOK:     true,
+		},
+		"GovCloud region": {
+			importID:   "vpc-12345678@us-gov-west-1", //lintignore:AWSAT003
+			wantID:     "vpc-12345678",
+			wantRegion: "us-gov-west-1", //lintignore:AWSAT003
+			wantOK:     true,
+		},
+		"email address": {
+			importID: "someone@example.com",
+			wantID:   "someone@example.com",
+		},
+		"email address and region": {
+			importID:   "someone@example.com@us-west-2", //lintignore:AWSAT003
+			wantID:     "someone@example.com",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+			wantOK:     true,
+		},
+		"region only": {
+			importID: "@us-west-2", //lintignore:AWSAT003
+			wantID:   "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion, gotOK := ParseImportIDRegion(testCase.importID)
+
+			if got, want := gotID, testCase.wantID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := gotRegion, testCase.wantRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := gotOK, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,8 +56,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_partitions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validPartitionID},
+				Optional:    true,
+				Description: "List of allowed AWS partitions, e.g. `aws`. The provider's Region and any per-resource Region override must be in one of these partitions.",
+			},
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidRegionName},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Description:   "List of allowed AWS Regions. The provider's Region and any per-resource Region override must be one of these Regions.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines record is appended for each AWS API call. " +
+					"Request and response payloads are not recorded.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -69,6 +91,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"propagate_to_children": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to apply resources' tags to the child resources they create implicitly.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -79,6 +106,46 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for a resource type. Timeouts configured in a resource's `timeouts` block take precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default Create timeout, e.g. `60m`.",
+						},
+						"delete": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default Delete timeout, e.g. `60m`.",
+						},
+						"read": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default Read timeout, e.g. `60m`.",
+						},
+						names.AttrResourceType: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type, e.g. `aws_db_instance`.",
+						},
+						"update": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default Update timeout, e.g. `60m`.",
+						},
+					},
+				},
+			},
+			"dry_run_report_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines record of each mutating AWS API call is appended instead of the call being made. " +
+					"Can also be configured using the `" + conns.DryRunReportPathEnvVar + "` environment variable.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -98,6 +165,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidRegionName},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Description:   "List of forbidden AWS Regions. The provider's Region and any per-resource Region override must not be one of these Regions.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -159,6 +233,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with settings to limit the rate of AWS API requests made to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of requests that can be made at once. Defaults to `tokens_per_second` rounded up.",
+						},
+						"operation": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with rate limits overriding the service's rate limit for individual API operations.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"burst": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The maximum number of requests that can be made at once. Defaults to `tokens_per_second` rounded up.",
+									},
+									names.AttrName: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the API operation, e.g. `ChangeResourceRecordSets`.",
+									},
+									"tokens_per_second": {
+										Type:        schema.TypeFloat,
+										Required:    true,
+										Description: "The maximum sustained number of requests per second. Reduced while the service returns throttling errors.",
+									},
+								},
+							},
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block.",
+						},
+						"tokens_per_second": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The maximum sustained number of requests per second for all of the service's API operations without an override. Reduced while the service returns throttling errors.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -171,6 +295,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retryable_errors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with additional retryable error conditions for a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "API error codes to retry.",
+						},
+						"error_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "Regular expression matching error messages to retry.",
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of attempts for a matching error. Defaults to `max_retries`.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "The maximum delay between attempts for a matching error, e.g. `30s`.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -235,6 +397,58 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that the tags of taggable resources must comply with.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyEnforcementModes(), false),
+							Description:  "How tag policy violations are reported. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"policy_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  "AWS Organizations tag policy document.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with tag rules.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The allowed tag values. A value ending in `*` allows any value with that prefix.",
+									},
+									"enforce_key_case": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag key must match `key` exactly.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag is required.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,6 +507,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			interceptors := interceptorItems{}
+			if v.Region.IsOverrideEnabled {
+				// The data source supports per-resource Region override.
+				// Ensure that the schema doesn't already define the attribute.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				addRegionAttribute(r)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: regionInterceptor(),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -316,10 +545,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if v.Region.IsOverrideEnabled {
+						overrideRegion = getOverrideRegion(getAttribute)
+						if diags = verifyOverrideRegion(ctx, meta, overrideRegion); diags.HasError() {
+							return ctx, diags
+						}
+					}
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -363,7 +600,47 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			var customizeDiffFuncs []schema.CustomizeDiffFunc
+			var importFuncs []importFunc
 			interceptors := interceptorItems{}
+			if v.Region.IsOverrideEnabled {
+				// The resource supports per-resource Region override.
+				if v, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					// The resource defines the attribute and sets the resource's Region in its Read handler,
+					// e.g. aws_s3_bucket, whose Region is discovered from the bucket.
+					// Ensure that the attribute can be used as an override.
+					if !v.Optional || !v.Computed || !v.ForceNew {
+						errs = append(errs, fmt.Errorf("`%s` attribute must be Optional, Computed and ForceNew: %s", names.AttrRegion, typeName))
+						continue
+					}
+				} else {
+					addRegionAttribute(r)
+					customizeDiffFuncs = append(customizeDiffFuncs, defaultRegion, forceNewIfRegionChanges)
+					interceptors = append(interceptors, interceptorItem{
+						when:        After,
+						why:         Create | Read | Update,
+						interceptor: regionInterceptor(),
+					})
+				}
+				importFuncs = append(importFuncs, importRegion)
+			}
+			if v.Identity.IsEnabled() {
+				// The resource has a resource identity.
+				// Ensure that the identity's attributes are defined in the schema.
+				if err := validateIdentityAttributes(v.Identity, r.SchemaMap()); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				r.Identity = newResourceIdentity(v.Identity)
+				if r.Importer != nil {
+					importFuncs = append(importFuncs, importIdentity(v.Identity, v.Region.IsOverrideEnabled))
+				}
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor(v.Identity),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -388,7 +665,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
-				customizeDiffFuncs = append(customizeDiffFuncs, setTagsAll)
+				customizeDiffFuncs = append(customizeDiffFuncs, setTagsAll, verifyTagPolicy)
 				interceptors = append(interceptors, interceptorItem{
 					when:        Before | After | Finally,
 					why:         Create | Read | Update,
@@ -398,10 +675,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if v.Region.IsOverrideEnabled {
+						overrideRegion = getOverrideRegion(getAttribute)
+						if diags = verifyOverrideRegion(ctx, meta, overrideRegion); diags.HasError() {
+							return ctx, diags
+						}
+					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -410,6 +695,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					return ctx, diags
 				},
 				customizeDiffFuncs: customizeDiffFuncs,
+				importFuncs:        importFuncs,
 				interceptors:       interceptors,
 				typeName:           typeName,
 			}
@@ -450,6 +736,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -487,6 +774,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_partitions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedPartitions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -534,6 +829,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]any)) > 0 {
+		defaultTimeouts, dx := expandDefaultTimeouts(ctx, cty.GetAttrPath("default_timeouts"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.DefaultTimeouts = defaultTimeouts
+	}
+
+	if v := os.Getenv(conns.DryRunReportPathEnvVar); v != "" {
+		config.DryRunReportPath = v
+	}
+	if v, ok := d.GetOk("dry_run_report_path"); ok {
+		config.DryRunReportPath = v.(string)
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -546,6 +857,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -567,10 +882,36 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(v.([]any)[0].(map[string]any))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]any)) > 0 {
+		rateLimits, dx := expandRateLimits(ctx, cty.GetAttrPath("rate_limits"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retryable_errors"); ok && len(v.([]any)) > 0 {
+		retryableErrors, dx := expandRetryableErrors(ctx, cty.GetAttrPath("retryable_errors"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RetryableErrors = retryableErrors
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -593,6 +934,11 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	} else {
 		c = new(conns.AWSClient)
 	}
+	diags = append(diags, applyDefaultTimeouts(ctx, provider, c, cty.GetAttrPath("default_timeouts"), config.DefaultTimeouts)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	c, ds := config.ConfigureProvider(ctx, c)
 	diags = append(diags, ds...)
 
@@ -852,10 +1198,20 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var propagateToChildren bool
+	if v, ok := tfMap["propagate_to_children"].(bool); ok {
+		propagateToChildren = v
+	}
+
+	if len(tags) > 0 || propagateToChildren {
+		defaultConfig := &tftags.DefaultConfig{
+			PropagateToChildren: propagateToChildren,
+		}
+		if len(tags) > 0 {
+			defaultConfig.Tags = tftags.New(ctx, tags)
 		}
+
+		return defaultConfig
 	}
 
 	return nil
@@ -909,6 +1265,291 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandRateLimits(_ context.Context, path cty.Path, tfList []any) (map[string]conns.ServiceRateLimits, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rateLimits := make(map[string]conns.ServiceRateLimits)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid service", fmt.Sprintf("%q is not a valid service name", service)))
+			continue
+		}
+
+		if _, ok := rateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Duplicate service", fmt.Sprintf("rate limits for %q are already configured", service)))
+			continue
+		}
+
+		limits := conns.ServiceRateLimits{
+			RateLimit: conns.RateLimit{
+				Burst:           tfMap["burst"].(int),
+				TokensPerSecond: tfMap["tokens_per_second"].(float64),
+			},
+			Operations: make(map[string]conns.RateLimit),
+		}
+
+		if limits.TokensPerSecond < 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("tokens_per_second"), "Invalid rate limit", "tokens_per_second must not be negative"))
+		}
+
+		for j, v := range tfMap["operation"].([]any) {
+			tfMap, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			operation := conns.RateLimit{
+				Burst:           tfMap["burst"].(int),
+				TokensPerSecond: tfMap["tokens_per_second"].(float64),
+			}
+
+			if operation.TokensPerSecond <= 0 {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("operation").IndexInt(j).GetAttr("tokens_per_second"), "Invalid rate limit", "tokens_per_second must be greater than 0"))
+			}
+
+			limits.Operations[tfMap[names.AttrName].(string)] = operation
+		}
+
+		rateLimits[servicePackageName] = limits
+	}
+
+	return rateLimits, diags
+}
+
+func expandTagPolicy(tfMap map[string]any) (*tftags.PolicyConfig, error) {
+	tagPolicy := tftags.PolicyConfig{
+		EnforcementMode: tftags.PolicyEnforcementModeError,
+	}
+
+	if v, ok := tfMap["enforcement_mode"].(string); ok && v != "" {
+		tagPolicy.EnforcementMode = v
+	}
+
+	if v, ok := tfMap["policy_document"].(string); ok && v != "" {
+		rules, err := tftags.PolicyRulesFromOrganizationsPolicy(v)
+		if err != nil {
+			return nil, fmt.Errorf("tag_policy: %w", err)
+		}
+		tagPolicy.Rules = append(tagPolicy.Rules, rules...)
+	}
+
+	if v, ok := tfMap["tag"].([]any); ok {
+		for _, v := range v {
+			tfMap, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap[names.AttrKey].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+				slices.Sort(rule.AllowedValues)
+			}
+
+			if v, ok := tfMap["enforce_key_case"].(bool); ok {
+				rule.EnforceKeyCase = v
+			}
+
+			if v, ok := tfMap["required"].(bool); ok {
+				rule.Required = v
+			}
+
+			tagPolicy.Rules = append(tagPolicy.Rules, rule)
+		}
+	}
+
+	return &tagPolicy, nil
+}
+
+func expandDefaultTimeouts(_ context.Context, path cty.Path, tfList []any) (map[string]conns.ResourceTimeouts, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	defaultTimeouts := make(map[string]conns.ResourceTimeouts)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		resourceType := tfMap[names.AttrResourceType].(string)
+
+		if _, ok := defaultTimeouts[resourceType]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr(names.AttrResourceType), "Duplicate resource type", fmt.Sprintf("default timeouts for %q are already configured", resourceType)))
+			continue
+		}
+
+		var resourceTimeouts conns.ResourceTimeouts
+		for _, operation := range []struct {
+			key     string
+			timeout **time.Duration
+		}{
+			{"create", &resourceTimeouts.Create},
+			{"read", &resourceTimeouts.Read},
+			{"update", &resourceTimeouts.Update},
+			{"delete", &resourceTimeouts.Delete},
+		} {
+			v, ok := tfMap[operation.key].(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			timeout, err := time.ParseDuration(v)
+			if err != nil || timeout <= 0 {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr(operation.key), "Invalid timeout", fmt.Sprintf("%q is not a valid positive duration", v)))
+				continue
+			}
+
+			*operation.timeout = &timeout
+		}
+
+		defaultTimeouts[resourceType] = resourceTimeouts
+	}
+
+	return defaultTimeouts, diags
+}
+
+// applyDefaultTimeouts sets Plugin SDK V2 resources' default operation timeouts from the provider-level defaults
+// and checks that each configured resource type supports the configured operation timeouts.
+// Plugin Framework resources read their defaults from the AWSClient.
+func applyDefaultTimeouts(ctx context.Context, provider *schema.Provider, c *conns.AWSClient, path cty.Path, defaultTimeouts map[string]conns.ResourceTimeouts) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, resourceType := range slices.Sorted(maps.Keys(defaultTimeouts)) {
+		defaults := defaultTimeouts[resourceType]
+
+		if r, ok := provider.ResourcesMap[resourceType]; ok {
+			if r.Timeouts == nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(path, "Unsupported resource type", fmt.Sprintf("resource type %q does not support timeouts", resourceType)))
+				continue
+			}
+
+			resourceTimeouts := *r.Timeouts
+			for _, operation := range []struct {
+				name     string
+				timeout  *time.Duration
+				resource **time.Duration
+			}{
+				{"create", defaults.Create, &resourceTimeouts.Create},
+				{"read", defaults.Read, &resourceTimeouts.Read},
+				{"update", defaults.Update, &resourceTimeouts.Update},
+				{"delete", defaults.Delete, &resourceTimeouts.Delete},
+			} {
+				if operation.timeout == nil {
+					continue
+				}
+
+				if *operation.resource == nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(path, "Unsupported timeout", fmt.Sprintf("resource type %q does not support a %s timeout", resourceType, operation.name)))
+					continue
+				}
+
+				*operation.resource = operation.timeout
+			}
+			r.Timeouts = &resourceTimeouts
+
+			continue
+		}
+
+		if !isFrameworkResourceWithTimeouts(ctx, c, resourceType) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path, "Unsupported resource type", fmt.Sprintf("resource type %q does not support timeouts", resourceType)))
+		}
+	}
+
+	return diags
+}
+
+// isFrameworkResourceWithTimeouts returns whether the specified resource type is a Plugin Framework resource
+// that supports the "timeouts" block.
+func isFrameworkResourceWithTimeouts(ctx context.Context, c *conns.AWSClient, resourceType string) bool {
+	for _, sp := range c.ServicePackages(ctx) {
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.TypeName != resourceType {
+				continue
+			}
+
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				return false
+			}
+
+			_, ok := inner.(interface {
+				CreateTimeout(context.Context, timeouts.Value) time.Duration
+			})
+
+			return ok
+		}
+	}
+
+	return false
+}
+
+func expandRetryableErrors(_ context.Context, path cty.Path, tfList []any) (map[string][]conns.RetryableErrorRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	retryableErrors := make(map[string][]conns.RetryableErrorRule)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid service", fmt.Sprintf("%q is not a valid service name", service)))
+			continue
+		}
+
+		rule := conns.RetryableErrorRule{
+			MaxAttempts: tfMap["max_attempts"].(int),
+		}
+
+		if v, ok := tfMap["error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["error_message"].(string); ok && v != "" {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("error_message"), "Invalid error message", err.Error()))
+				continue
+			}
+			rule.ErrorMessage = re
+		}
+
+		if len(rule.ErrorCodes) == 0 && rule.ErrorMessage == nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath, "Invalid retryable error", "at least one of error_codes or error_message must be set"))
+			continue
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("max_backoff"), "Invalid max backoff", err.Error()))
+				continue
+			}
+			rule.MaxBackoff = d
+		}
+
+		retryableErrors[servicePackageName] = append(retryableErrors[servicePackageName], rule)
+	}
+
+	return retryableErrors, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -6,14 +6,22 @@ package provider
 import (
 	"context"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	frameworkprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -44,6 +52,29 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestListResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want int
+	for _, sp := range servicePackages(ctx) {
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			want += len(v.SDKListResources(ctx))
+		}
+	}
+
+	// List resources that fail registration are logged and skipped.
+	if got := len(fwprovider.New(p).(frameworkprovider.ProviderWithListResources).ListResources(ctx)); got != want {
+		t.Errorf("ListResources() = %d list resources, want %d", got, want)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -235,6 +266,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
 		tags                  map[string]any
+		propagateToChildren   bool
 		envvars               map[string]string
 		expectedDefaultConfig *tftags.DefaultConfig
 	}{
@@ -243,6 +275,14 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 			envvars:               map[string]string{},
 			expectedDefaultConfig: nil,
 		},
+		"propagate_to_children": {
+			tags:                nil,
+			propagateToChildren: true,
+			envvars:             map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				PropagateToChildren: true,
+			},
+		},
 		"envvar": {
 			tags: nil,
 			envvars: map[string]string{
@@ -292,7 +332,8 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandDefaultTags(ctx, map[string]any{
-				"tags": testcase.tags,
+				"propagate_to_children": testcase.propagateToChildren,
+				"tags":                  testcase.tags,
 			})
 
 			if results == nil {
@@ -301,7 +342,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				} else {
 					t.Errorf("Expected default tags config to be %v, got nil", testcase.expectedDefaultConfig)
 				}
-			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) {
+			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) || testcase.expectedDefaultConfig.GetPropagateToChildren() != results.PropagateToChildren {
 				t.Errorf("Expected default tags config to be %v, got %v", testcase.expectedDefaultConfig, results)
 			}
 		})
@@ -438,3 +479,436 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ServiceRateLimits
+		expectedDiags diag.Diagnostics
+	}{
+		"service": {
+			tfList: []any{
+				map[string]any{
+					"burst":             0,
+					"operation":         []any{},
+					"service":           names.IAM,
+					"tokens_per_second": 2.5,
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				names.IAM: {
+					RateLimit:  conns.RateLimit{TokensPerSecond: 2.5},
+					Operations: map[string]conns.RateLimit{},
+				},
+			},
+		},
+		"operation overrides": {
+			tfList: []any{
+				map[string]any{
+					"burst": 10,
+					"operation": []any{
+						map[string]any{
+							"burst":             0,
+							names.AttrName:      "ChangeResourceRecordSets",
+							"tokens_per_second": 1.0,
+						},
+					},
+					"service":           names.Route53,
+					"tokens_per_second": 5.0,
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				names.Route53: {
+					RateLimit: conns.RateLimit{Burst: 10, TokensPerSecond: 5},
+					Operations: map[string]conns.RateLimit{
+						"ChangeResourceRecordSets": {TokensPerSecond: 1},
+					},
+				},
+			},
+		},
+		"invalid service": {
+			tfList: []any{
+				map[string]any{
+					"burst":             0,
+					"operation":         []any{},
+					"service":           "not-a-service",
+					"tokens_per_second": 1.0,
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("rate_limits").IndexInt(0).GetAttr("service"), "Invalid service", `"not-a-service" is not a valid service name`),
+			},
+		},
+		"invalid operation rate": {
+			tfList: []any{
+				map[string]any{
+					"burst": 0,
+					"operation": []any{
+						map[string]any{
+							"burst":             0,
+							names.AttrName:      "CreateAccount",
+							"tokens_per_second": 0.0,
+						},
+					},
+					"service":           names.Organizations,
+					"tokens_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.ServiceRateLimits{
+				names.Organizations: {
+					Operations: map[string]conns.RateLimit{
+						"CreateAccount": {},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("rate_limits").IndexInt(0).GetAttr("operation").IndexInt(0).GetAttr("tokens_per_second"), "Invalid rate limit", "tokens_per_second must be greater than 0"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandRateLimits(ctx, cty.GetAttrPath("rate_limits"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ResourceTimeouts
+		expectedDiags diag.Diagnostics
+	}{
+		"create and delete": {
+			tfList: []any{
+				map[string]any{
+					"create":               "90m",
+					"delete":               "2h",
+					"read":                 "",
+					names.AttrResourceType: "aws_db_instance",
+					"update":               "",
+				},
+			},
+			expected: map[string]conns.ResourceTimeouts{
+				"aws_db_instance": {
+					Create: aws.Duration(90 * time.Minute),
+					Delete: aws.Duration(2 * time.Hour),
+				},
+			},
+		},
+		"duplicate resource type": {
+			tfList: []any{
+				map[string]any{
+					"create":               "",
+					"delete":               "",
+					"read":                 "",
+					names.AttrResourceType: "aws_eks_cluster",
+					"update":               "60m",
+				},
+				map[string]any{
+					"create":               "60m",
+					"delete":               "",
+					"read":                 "",
+					names.AttrResourceType: "aws_eks_cluster",
+					"update":               "",
+				},
+			},
+			expected: map[string]conns.ResourceTimeouts{
+				"aws_eks_cluster": {
+					Update: aws.Duration(60 * time.Minute),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("default_timeouts").IndexInt(1).GetAttr(names.AttrResourceType), "Duplicate resource type", `default timeouts for "aws_eks_cluster" are already configured`),
+			},
+		},
+		"invalid duration": {
+			tfList: []any{
+				map[string]any{
+					"create":               "forever",
+					"delete":               "",
+					"read":                 "",
+					names.AttrResourceType: "aws_opensearch_domain",
+					"update":               "",
+				},
+			},
+			expected: map[string]conns.ResourceTimeouts{
+				"aws_opensearch_domain": {},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("default_timeouts").IndexInt(0).GetAttr("create"), "Invalid timeout", `"forever" is not a valid positive duration`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandDefaultTimeouts(ctx, cty.GetAttrPath("default_timeouts"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap       map[string]any
+		expected    *tftags.PolicyConfig
+		expectError bool
+	}{
+		"defaults": {
+			tfMap: map[string]any{
+				"enforcement_mode": "",
+				"policy_document":  "",
+				"tag":              []any{},
+			},
+			expected: &tftags.PolicyConfig{
+				EnforcementMode: tftags.PolicyEnforcementModeError,
+			},
+		},
+		"tags and policy document": {
+			tfMap: map[string]any{
+				"enforcement_mode": tftags.PolicyEnforcementModeWarn,
+				"policy_document":  `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"}}}}`,
+				"tag": []any{
+					map[string]any{
+						"allowed_values":   schema.NewSet(schema.HashString, []any{"team2", "team1"}),
+						"enforce_key_case": false,
+						names.AttrKey:      "Owner",
+						"required":         true,
+					},
+				},
+			},
+			expected: &tftags.PolicyConfig{
+				EnforcementMode: tftags.PolicyEnforcementModeWarn,
+				Rules: []tftags.PolicyRule{
+					{Key: "CostCenter", EnforceKeyCase: true},
+					{Key: "Owner", Required: true, AllowedValues: []string{"team1", "team2"}},
+				},
+			},
+		},
+		"invalid policy document": {
+			tfMap: map[string]any{
+				"enforcement_mode": "",
+				"policy_document":  `{"tags":{"costcenter":{"tag_key":{"@@assign":"Owner"}}}}`,
+				"tag":              []any{},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandTagPolicy(testcase.tfMap)
+
+			if got, want := err != nil, testcase.expectError; got != want {
+				t.Fatalf("expandTagPolicy() err %t, want %t (%v)", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := cty.GetAttrPath("default_timeouts")
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_db_instance": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(40 * time.Minute),
+					Update: schema.DefaultTimeout(80 * time.Minute),
+					Delete: schema.DefaultTimeout(60 * time.Minute),
+				},
+			},
+			"aws_vpc": {},
+		},
+	}
+	c := new(conns.AWSClient)
+
+	diags := applyDefaultTimeouts(ctx, provider, c, path, map[string]conns.ResourceTimeouts{
+		"aws_db_instance": {
+			Create: aws.Duration(90 * time.Minute),
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	timeouts := provider.ResourcesMap["aws_db_instance"].Timeouts
+	if got, want := aws.ToDuration(timeouts.Create), 90*time.Minute; got != want {
+		t.Errorf("Create timeout = %s, want %s", got, want)
+	}
+	if got, want := aws.ToDuration(timeouts.Delete), 60*time.Minute; got != want {
+		t.Errorf("Delete timeout = %s, want %s", got, want)
+	}
+
+	diags = applyDefaultTimeouts(ctx, provider, c, path, map[string]conns.ResourceTimeouts{
+		"aws_db_instance": {
+			Read: aws.Duration(time.Minute),
+		},
+		"aws_not_a_resource": {
+			Create: aws.Duration(time.Minute),
+		},
+		"aws_vpc": {
+			Create: aws.Duration(time.Minute),
+		},
+	})
+	expectedDiags := diag.Diagnostics{
+		errs.NewAttributeErrorDiagnostic(path, "Unsupported timeout", `resource type "aws_db_instance" does not support a read timeout`),
+		errs.NewAttributeErrorDiagnostic(path, "Unsupported resource type", `resource type "aws_not_a_resource" does not support timeouts`),
+		errs.NewAttributeErrorDiagnostic(path, "Unsupported resource type", `resource type "aws_vpc" does not support timeouts`),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestExpandRetryableErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string][]conns.RetryableErrorRule
+		expectedDiags diag.Diagnostics
+	}{
+		"error codes": {
+			tfList: []any{
+				map[string]any{
+					"error_codes":   schema.NewSet(schema.HashString, []any{"InvalidParameterValue"}),
+					"error_message": "",
+					"max_attempts":  10,
+					"max_backoff":   "30s",
+					"service":       names.Lambda,
+				},
+			},
+			expected: map[string][]conns.RetryableErrorRule{
+				names.Lambda: {
+					{
+						ErrorCodes:  []string{"InvalidParameterValue"},
+						MaxAttempts: 10,
+						MaxBackoff:  30 * time.Second,
+					},
+				},
+			},
+		},
+		"multiple rules": {
+			tfList: []any{
+				map[string]any{
+					"error_codes":   schema.NewSet(schema.HashString, []any{}),
+					"error_message": "is not authorized to perform",
+					"max_attempts":  0,
+					"max_backoff":   "",
+					"service":       names.IAM,
+				},
+				map[string]any{
+					"error_codes":   schema.NewSet(schema.HashString, []any{"NoSuchEntity"}),
+					"error_message": "",
+					"max_attempts":  5,
+					"max_backoff":   "",
+					"service":       names.IAM,
+				},
+			},
+			expected: map[string][]conns.RetryableErrorRule{
+				names.IAM: {
+					{
+						ErrorMessage: regexache.MustCompile("is not authorized to perform"),
+					},
+					{
+						ErrorCodes:  []string{"NoSuchEntity"},
+						MaxAttempts: 5,
+					},
+				},
+			},
+		},
+		"invalid service": {
+			tfList: []any{
+				map[string]any{
+					"error_codes":   schema.NewSet(schema.HashString, []any{"InvalidParameterValue"}),
+					"error_message": "",
+					"max_attempts":  0,
+					"max_backoff":   "",
+					"service":       "not-a-service",
+				},
+			},
+			expected: map[string][]conns.RetryableErrorRule{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("retryable_errors").IndexInt(0).GetAttr("service"), "Invalid service", `"not-a-service" is not a valid service name`),
+			},
+		},
+		"no condition": {
+			tfList: []any{
+				map[string]any{
+					"error_codes":   schema.NewSet(schema.HashString, []any{}),
+					"error_message": "",
+					"max_attempts":  3,
+					"max_backoff":   "",
+					"service":       names.EC2,
+				},
+			},
+			expected: map[string][]conns.RetryableErrorRule{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("retryable_errors").IndexInt(0), "Invalid retryable error", "at least one of error_codes or error_message must be set"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandRetryableErrors(ctx, cty.GetAttrPath("retryable_errors"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				if x == nil || y == nil {
+					return x == y
+				}
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema for the top-level `region` attribute injected into resources and data sources.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Region where this resource will be managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// addRegionAttribute adds the top-level `region` attribute to the resource's schema.
+// The schema map is copied as some resources share a package-level schema map.
+func addRegionAttribute(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := maps.Clone(f())
+			s[names.AttrRegion] = regionSchema()
+			return s
+		}
+	} else {
+		r.Schema = maps.Clone(r.Schema)
+		r.Schema[names.AttrRegion] = regionSchema()
+	}
+}
+
+// getOverrideRegion returns the value of any per-resource Region override.
+func getOverrideRegion(getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// verifyOverrideRegion returns an error diagnostic if the provider configuration does not permit
+// operating in the per-resource Region override.
+func verifyOverrideRegion(ctx context.Context, meta any, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if region == "" {
+		return diags
+	}
+
+	if c, ok := meta.(*conns.AWSClient); ok {
+		if err := c.VerifyRegionAllowed(ctx, region); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+// defaultRegion is a CustomizeDiff function that sets the resource's planned Region
+// to the provider's configured Region if no per-resource override is configured.
+func defaultRegion(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+		if err := d.SetNew(names.AttrRegion, meta.(*conns.AWSClient).ProviderRegion(ctx)); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// forceNewIfRegionChanges is a CustomizeDiff function that forces resource replacement if the resource's Region changes.
+func forceNewIfRegionChanges(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	// New resource.
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.HasChange(names.AttrRegion) {
+		return nil
+	}
+
+	// Resources created before per-resource Region override was supported have no Region in state.
+	if o, n := d.GetChange(names.AttrRegion); o.(string) == "" && n.(string) == meta.(*conns.AWSClient).ProviderRegion(ctx) {
+		return nil
+	}
+
+	if err := d.ForceNew(names.AttrRegion); err != nil {
+		return fmt.Errorf("forcing replacement on %s change: %w", names.AttrRegion, err)
+	}
+
+	return nil
+}
+
+// importRegion is an importFunc that sets the resource's Region from any `@<region>` suffix of the import ID.
+func importRegion(_ context.Context, d *schema.ResourceData, _ any) error {
+	if id, region, ok := interceptors.ParseImportIDRegion(d.Id()); ok {
+		d.SetId(id)
+		if err := d.Set(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
+
+// regionInterceptor sets the resource's effective Region in state after Create, Read and Update.
+func regionInterceptor() interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+				if d.Id() == "" {
+					return diags
+				}
+
+				fallthrough
+			case Create, Update:
+				if err := d.Set(names.AttrRegion, opts.c.Region(ctx)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -57,6 +58,12 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			if tagPolicy := c.TagPolicyConfig(ctx); tagPolicy.WarnOnly() {
+				for _, v := range tagPolicy.Evaluate(tags.IgnoreConfig(c.IgnoreTagsConfig(ctx))) {
+					diags = sdkdiag.AppendWarningf(diags, "tag policy violation (%s): %s", tagPolicyViolationPath(v), v.Message)
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -316,3 +323,37 @@ func setTagsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 
 	return nil
 }
+
+// verifyTagPolicy is a CustomizeDiff function that checks the new value of the `tags_all` attribute against any provider configured tag_policy.
+// Plugin SDK V2 CustomizeDiff functions cannot return warnings, so violations of a warn-only tag policy are reported
+// by the tagging interceptor before Create and Update.
+func verifyTagPolicy(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	tagPolicy := c.TagPolicyConfig(ctx)
+	if tagPolicy == nil || tagPolicy.WarnOnly() {
+		return nil
+	}
+
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+
+	var errs []error
+	for _, v := range tagPolicy.Evaluate(allTags) {
+		errs = append(errs, fmt.Errorf("tag policy violation (%s): %s", tagPolicyViolationPath(v), v.Message))
+	}
+
+	return errors.Join(errs...)
+}
+
+// tagPolicyViolationPath returns the path of the `tags_all` attribute or element that violates a tag policy.
+func tagPolicyViolationPath(v tftags.PolicyViolation) string {
+	if v.Key == "" {
+		return names.AttrTagsAll
+	}
+
+	return fmt.Sprintf("%s[%q]", names.AttrTagsAll, v.Key)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -37,3 +38,18 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validPartitionID validates that a string is the ID of a known AWS partition, e.g. "aws-us-gov".
+func validPartitionID(v any, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		if partition.ID() == value {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not a known AWS partition", k, value))
+
+	return
+}
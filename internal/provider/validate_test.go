@@ -73,3 +73,19 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPartitionID(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"aws", "aws-cn", "aws-us-gov"} {
+		if _, errs := validPartitionID(v, "test_property"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "AWS", "us-east-1", "aws-unknown"} {
+		if _, errs := validPartitionID(v, "test_property"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
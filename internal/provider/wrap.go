@@ -19,6 +19,9 @@ type getAttributeFunc func(string) (any, bool)
 // contextFunc augments Context.
 type contextFunc func(context.Context, getAttributeFunc, any) (context.Context, diag.Diagnostics)
 
+// importFunc is run before a resource's import handler.
+type importFunc func(context.Context, *schema.ResourceData, any) error
+
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -42,13 +45,14 @@ func wrapDataSource(r *schema.Resource, opts wrappedDataSourceOptions) {
 }
 
 func (w *wrappedDataSource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName, Read)
 }
 
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext   contextFunc
 	customizeDiffFuncs []schema.CustomizeDiffFunc
+	importFuncs        []importFunc
 	interceptors       interceptorItems
 	typeName           string
 }
@@ -81,7 +85,7 @@ func (w *wrappedResource) create(f schema.CreateContextFunc) schema.CreateContex
 		return nil
 	}
 
-	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create)
+	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName, Create)
 }
 
 func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
@@ -89,7 +93,7 @@ func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc
 		return nil
 	}
 
-	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName, Read)
 }
 
 func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
@@ -97,7 +101,7 @@ func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContex
 		return nil
 	}
 
-	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update)
+	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName, Update)
 }
 
 func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
@@ -105,7 +109,7 @@ func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContex
 		return nil
 	}
 
-	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete)
+	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, w.opts.typeName, Delete)
 }
 
 func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFunc {
@@ -114,6 +118,12 @@ func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFu
 	}
 
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		for _, f := range w.opts.importFuncs {
+			if err := f(ctx, d, meta); err != nil {
+				return nil, err
+			}
+		}
+
 		ctx, diags := w.opts.bootstrapContext(ctx, d.GetOk, meta)
 		if diags.HasError() {
 			return nil, sdkdiag.DiagnosticsError(diags)
@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceArchiveRule,
 			TypeName: "aws_accessanalyzer_archive_rule",
 			Name:     "Archive Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
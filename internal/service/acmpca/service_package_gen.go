@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCertificate,
 			TypeName: "aws_acmpca_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceCertificateAuthority,
@@ -36,6 +37,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -46,6 +48,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificate,
 			TypeName: "aws_acmpca_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCertificateAuthority,
@@ -54,21 +57,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCertificateAuthorityCertificate,
 			TypeName: "aws_acmpca_certificate_authority_certificate",
 			Name:     "Certificate Authority Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePermission,
 			TypeName: "aws_acmpca_permission",
 			Name:     "Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_acmpca_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
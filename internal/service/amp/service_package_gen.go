@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDefaultScraperConfigurationDataSource,
 			TypeName: "aws_prometheus_default_scraper_configuration",
 			Name:     "Default Scraper Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -33,6 +34,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -44,11 +46,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_prometheus_workspace",
 			Name:     "Workspace",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceWorkspaces,
 			TypeName: "aws_prometheus_workspaces",
 			Name:     "Workspaces",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -59,6 +63,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlertManagerDefinition,
 			TypeName: "aws_prometheus_alert_manager_definition",
 			Name:     "Alert Manager Definition",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRuleGroupNamespace,
@@ -67,6 +72,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWorkspace,
@@ -75,6 +81,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
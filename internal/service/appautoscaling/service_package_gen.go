@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePolicy,
 			TypeName: "aws_appautoscaling_policy",
 			Name:     "Scaling Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceScheduledAction,
 			TypeName: "aws_appautoscaling_scheduled_action",
 			Name:     "Scheduled Action",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceTarget,
@@ -45,6 +47,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
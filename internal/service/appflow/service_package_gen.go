@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceConnectorProfile,
 			TypeName: "aws_appflow_connector_profile",
 			Name:     "Connector Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFlow,
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceControl,
 			TypeName: "aws_auditmanager_control",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceFramework,
 			TypeName: "aws_auditmanager_framework",
 			Name:     "Framework",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,6 +37,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAccountRegistration,
 			TypeName: "aws_auditmanager_account_registration",
 			Name:     "Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessment,
@@ -43,16 +46,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessmentDelegation,
 			TypeName: "aws_auditmanager_assessment_delegation",
 			Name:     "Assessment Delegation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessmentReport,
 			TypeName: "aws_auditmanager_assessment_report",
 			Name:     "Assessment Report",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceControl,
@@ -61,6 +67,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFramework,
@@ -69,16 +76,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFrameworkShare,
 			TypeName: "aws_auditmanager_framework_share",
 			Name:     "Framework Share",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceOrganizationAdminAccountRegistration,
 			TypeName: "aws_auditmanager_organization_admin_account_registration",
 			Name:     "Organization Admin Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,16 +28,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceGroup,
 			TypeName: "aws_autoscaling_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceGroups,
 			TypeName: "aws_autoscaling_groups",
 			Name:     "Groups",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceLaunchConfiguration,
 			TypeName: "aws_launch_configuration",
 			Name:     "Launch Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,46 +51,55 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAttachment,
 			TypeName: "aws_autoscaling_attachment",
 			Name:     "Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceGroup,
 			TypeName: "aws_autoscaling_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceGroupTag,
 			TypeName: "aws_autoscaling_group_tag",
 			Name:     "Group Tag",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLifecycleHook,
 			TypeName: "aws_autoscaling_lifecycle_hook",
 			Name:     "Lifecycle Hook",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceNotification,
 			TypeName: "aws_autoscaling_notification",
 			Name:     "Notification",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_autoscaling_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSchedule,
 			TypeName: "aws_autoscaling_schedule",
 			Name:     "Scheduled Action",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceTrafficSourceAttachment,
 			TypeName: "aws_autoscaling_traffic_source_attachment",
 			Name:     "Traffic Source Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLaunchConfiguration,
 			TypeName: "aws_launch_configuration",
 			Name:     "Launch Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,31 +20,37 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newCustomModelDataSource,
 			TypeName: "aws_bedrock_custom_model",
 			Name:     "Custom Model",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newCustomModelsDataSource,
 			TypeName: "aws_bedrock_custom_models",
 			Name:     "Custom Models",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newFoundationModelDataSource,
 			TypeName: "aws_bedrock_foundation_model",
 			Name:     "Foundation Model",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newFoundationModelsDataSource,
 			TypeName: "aws_bedrock_foundation_models",
 			Name:     "Foundation Models",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newInferenceProfileDataSource,
 			TypeName: "aws_bedrock_inference_profile",
 			Name:     "Inference Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newInferenceProfilesDataSource,
 			TypeName: "aws_bedrock_inference_profiles",
 			Name:     "Inference Profiles",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -58,6 +64,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "job_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceGuardrail,
@@ -66,11 +73,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "guardrail_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newGuardrailVersionResource,
 			TypeName: "aws_bedrock_guardrail_version",
 			Name:     "Guardrail Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceInferenceProfile,
@@ -79,11 +88,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newModelInvocationLoggingConfigurationResource,
 			TypeName: "aws_bedrock_model_invocation_logging_configuration",
 			Name:     "Model Invocation Logging Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newProvisionedModelThroughputResource,
@@ -92,6 +103,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "provisioned_model_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
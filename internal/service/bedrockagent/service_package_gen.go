@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceAgentVersions,
 			TypeName: "aws_bedrockagent_agent_versions",
 			Name:     "Agent Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -33,11 +34,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "agent_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentActionGroupResource,
 			TypeName: "aws_bedrockagent_agent_action_group",
 			Name:     "Agent Action Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentAliasResource,
@@ -46,21 +49,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "agent_alias_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentCollaboratorResource,
 			TypeName: "aws_bedrockagent_agent_collaborator",
 			Name:     "Agent Collaborator",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentKnowledgeBaseAssociationResource,
 			TypeName: "aws_bedrockagent_agent_knowledge_base_association",
 			Name:     "Agent Knowledge Base Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceResource,
 			TypeName: "aws_bedrockagent_data_source",
 			Name:     "Data Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newKnowledgeBaseResource,
@@ -69,6 +76,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
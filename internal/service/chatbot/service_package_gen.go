@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceSlackWorkspace,
 			TypeName: "aws_chatbot_slack_workspace",
 			Name:     "Slack Workspace",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -33,6 +34,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "chat_configuration_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newTeamsChannelConfigurationResource,
@@ -41,6 +43,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "chat_configuration_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalSettings,
 			TypeName: "aws_chimesdkvoice_global_settings",
 			Name:     "Global Settings",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSipMediaApplication,
@@ -40,11 +41,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSipRule,
 			TypeName: "aws_chimesdkvoice_sip_rule",
 			Name:     "Sip Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceVoiceProfileDomain,
@@ -53,6 +56,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceEnvironmentMembership,
 			TypeName: "aws_cloud9_environment_membership",
 			Name:     "Environment Membership",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
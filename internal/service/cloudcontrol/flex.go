@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func expandListResourcesInput(ctx context.Context, data resourcesDataSourceModel) (*cloudcontrol.ListResourcesInput, diag.Diagnostics) {
+	var input cloudcontrol.ListResourcesInput
+	diags := fwflex.Expand(ctx, data, &input)
+
+	return &input, diags
+}
+
+// flattenDecodedProperties returns an object whose attributes are the resources' identifiers
+// and whose values are the resources' decoded JSON properties.
+func flattenDecodedProperties(apiObjects []awstypes.ResourceDescription) (types.Dynamic, error) {
+	attributeTypes := make(map[string]attr.Type, len(apiObjects))
+	attributes := make(map[string]attr.Value, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		identifier := aws.ToString(apiObject.Identifier)
+
+		var value attr.Value = types.StringNull()
+		if v := aws.ToString(apiObject.Properties); v != "" {
+			var err error
+			value, err = decodeJSONValue(v)
+
+			if err != nil {
+				return types.DynamicNull(), fmt.Errorf("decoding properties of resource (%s): %w", identifier, err)
+			}
+		}
+
+		attributeTypes[identifier] = value.Type(context.Background())
+		attributes[identifier] = value
+	}
+
+	value, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		return types.DynamicNull(), fmt.Errorf("building decoded properties: %v", diags.Errors())
+	}
+
+	return types.DynamicValue(value), nil
+}
+
+// decodeJSONValue decodes a JSON document into a Terraform value.
+// Objects become objects, arrays become tuples and numbers keep their full precision.
+func decodeJSONValue(s string) (attr.Value, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after top-level JSON value")
+	}
+
+	return jsonValueToAttrValue(v)
+}
+
+func jsonValueToAttrValue(v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(string(v), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NumberValue(f), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+
+		for _, e := range v {
+			element, err := jsonValueToAttrValue(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes = append(elementTypes, element.Type(context.Background()))
+			elements = append(elements, element)
+		}
+
+		value, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building tuple: %v", diags.Errors())
+		}
+
+		return value, nil
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+
+		for k, e := range v {
+			attribute, err := jsonValueToAttrValue(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[k] = attribute.Type(context.Background())
+			attributes[k] = attribute
+		}
+
+		value, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building object: %v", diags.Errors())
+		}
+
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value type: %T", v)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestExpandListResourcesInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data     resourcesDataSourceModel
+		expected *cloudcontrol.ListResourcesInput
+	}{
+		"type name only": {
+			data: resourcesDataSourceModel{
+				ResourceModel: jsontypes.NewNormalizedNull(),
+				RoleARN:       fwtypes.ARNNull(),
+				TypeName:      types.StringValue("AWS::ECS::Service"),
+				TypeVersionID: types.StringNull(),
+			},
+			expected: &cloudcontrol.ListResourcesInput{
+				TypeName: aws.String("AWS::ECS::Service"),
+			},
+		},
+		"resource model filter": {
+			data: resourcesDataSourceModel{
+				ResourceModel: jsontypes.NewNormalizedValue(`{"Cluster":"example"}`),
+				RoleARN:       fwtypes.ARNValue("arn:aws:iam::123456789012:role/example"),
+				TypeName:      types.StringValue("AWS::ECS::Service"),
+				TypeVersionID: types.StringValue("00000001"),
+			},
+			expected: &cloudcontrol.ListResourcesInput{
+				ResourceModel: aws.String(`{"Cluster":"example"}`),
+				RoleArn:       aws.String("arn:aws:iam::123456789012:role/example"),
+				TypeName:      aws.String("AWS::ECS::Service"),
+				TypeVersionId: aws.String("00000001"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandListResourcesInput(context.Background(), testCase.data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(cloudcontrol.ListResourcesInput{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDecodedProperties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, err := flattenDecodedProperties([]awstypes.ResourceDescription{
+		{
+			Identifier: aws.String("cluster|service1"),
+			Properties: aws.String(`{"DesiredCount":2,"Enabled":true,"Name":"service1","Subnets":["a","b"],"Tags":null}`),
+		},
+		{
+			Identifier: aws.String("cluster|service2"),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	subnets, _ := types.TupleValue(
+		[]attr.Type{types.StringType, types.StringType},
+		[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+	)
+	service1, _ := types.ObjectValue(
+		map[string]attr.Type{
+			"DesiredCount": types.NumberType,
+			"Enabled":      types.BoolType,
+			"Name":         types.StringType,
+			"Subnets":      subnets.Type(ctx),
+			"Tags":         types.StringType,
+		},
+		map[string]attr.Value{
+			"DesiredCount": types.NumberValue(big.NewFloat(2)),
+			"Enabled":      types.BoolValue(true),
+			"Name":         types.StringValue("service1"),
+			"Subnets":      subnets,
+			"Tags":         types.StringNull(),
+		},
+	)
+	expected, _ := types.ObjectValue(
+		map[string]attr.Type{
+			"cluster|service1": service1.Type(ctx),
+			"cluster|service2": types.StringType,
+		},
+		map[string]attr.Value{
+			"cluster|service1": service1,
+			"cluster|service2": types.StringNull(),
+		},
+	)
+
+	if !got.Equal(types.DynamicValue(expected)) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestDecodeJSONValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{`{`, `{} {}`, `not json`} {
+		if _, err := decodeJSONValue(s); err == nil {
+			t.Errorf("expected error decoding %q", s)
+		}
+	}
+}
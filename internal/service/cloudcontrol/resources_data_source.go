@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudcontrolapi_resources", name="Resources")
+func newResourcesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &resourcesDataSource{}, nil
+}
+
+type resourcesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *resourcesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"decoded_properties": schema.DynamicAttribute{
+				Computed: true,
+			},
+			names.AttrID:        framework.IDAttribute(),
+			names.AttrResources: framework.DataSourceComputedListOfObjectAttribute[resourceDescriptionModel](ctx),
+			"resource_model": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"type_version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourcesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudControlClient(ctx)
+
+	input, diags := expandListResourcesInput(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := data.TypeName.ValueString()
+	resourceDescriptions, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Cloud Control API (%s) Resources", typeName), err.Error())
+
+		return
+	}
+
+	output := cloudcontrol.ListResourcesOutput{
+		ResourceDescriptions: resourceDescriptions,
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.DecodedProperties, err = flattenDecodedProperties(resourceDescriptions)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resources", typeName), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(typeName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findResources(ctx context.Context, conn *cloudcontrol.Client, input *cloudcontrol.ListResourcesInput) ([]awstypes.ResourceDescription, error) {
+	var output []awstypes.ResourceDescription
+
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceDescriptions...)
+	}
+
+	return output, nil
+}
+
+type resourcesDataSourceModel struct {
+	DecodedProperties    types.Dynamic                                             `tfsdk:"decoded_properties" autoflex:"-"`
+	ID                   types.String                                              `tfsdk:"id"`
+	ResourceDescriptions fwtypes.ListNestedObjectValueOf[resourceDescriptionModel] `tfsdk:"resources"`
+	ResourceModel        jsontypes.Normalized                                      `tfsdk:"resource_model"`
+	RoleARN              fwtypes.ARN                                               `tfsdk:"role_arn"`
+	TypeName             types.String                                              `tfsdk:"type_name"`
+	TypeVersionID        types.String                                              `tfsdk:"type_version_id"`
+}
+
+type resourceDescriptionModel struct {
+	Identifier types.String         `tfsdk:"identifier"`
+	Properties jsontypes.Normalized `tfsdk:"properties"`
+}
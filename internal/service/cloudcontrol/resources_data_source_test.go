@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "AWS::Logs::LogGroup"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resources.*.identifier", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResourcesDataSource_resourceModel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_resourceModel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.identifier"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.properties"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_base(rName), `
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`)
+}
+
+func testAccResourcesDataSourceConfig_resourceModel(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "filter" {
+  type_name = "AWS::Logs::MetricFilter"
+
+  desired_state = jsonencode({
+    FilterName    = %[1]q
+    FilterPattern = ""
+    LogGroupName  = aws_cloudcontrolapi_resource.test.id
+
+    MetricTransformations = [{
+      MetricName      = %[1]q
+      MetricNamespace = %[1]q
+      MetricValue     = "1"
+    }]
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.filter.type_name
+
+  resource_model = jsonencode({
+    LogGroupName = aws_cloudcontrolapi_resource.test.id
+  })
+
+  depends_on = [aws_cloudcontrolapi_resource.filter]
+}
+`, rName))
+}
@@ -15,7 +15,14 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newResourcesDataSource,
+			TypeName: "aws_cloudcontrolapi_resources",
+			Name:     "Resources",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -28,6 +35,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceResource,
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,6 +46,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceResource,
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
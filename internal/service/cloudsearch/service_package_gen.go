@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDomain,
 			TypeName: "aws_cloudsearch_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainServiceAccessPolicy,
 			TypeName: "aws_cloudsearch_domain_service_access_policy",
 			Name:     "Domain Service Access Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceProfilingGroup,
 			TypeName: "aws_codeguruprofiler_profiling_group",
 			Name:     "Profiling Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -33,6 +34,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
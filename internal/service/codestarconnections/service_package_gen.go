@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceConnection,
 			TypeName: "aws_codestarconnections_connection",
 			Name:     "Connection",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -41,11 +42,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceHost,
 			TypeName: "aws_codestarconnections_host",
 			Name:     "Host",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_config_aggregate_authorization", name="Aggregate Authorization")
+// @Region(overrideEnabled=false)
 // @Tags(identifierAttribute="arn")
 func resourceAggregateAuthorization() *schema.Resource {
 	return &schema.Resource{
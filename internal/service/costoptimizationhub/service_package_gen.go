@@ -26,11 +26,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceEnrollmentStatus,
 			TypeName: "aws_costoptimizationhub_enrollment_status",
 			Name:     "Enrollment Status",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourcePreferences,
 			TypeName: "aws_costoptimizationhub_preferences",
 			Name:     "Preferences",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -33,6 +33,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "report_name",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -46,6 +47,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "report_name",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceProfile,
 			TypeName: "aws_customerprofiles_profile",
 			Name:     "Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
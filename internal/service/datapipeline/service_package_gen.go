@@ -29,11 +29,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_datapipeline_pipeline",
 			Name:     "Pipeline",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourcePipelineDefinition,
 			TypeName: "aws_datapipeline_pipeline_definition",
 			Name:     "Pipeline Definition",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,11 +50,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Pipeline",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePipelineDefinition,
 			TypeName: "aws_datapipeline_pipeline_definition",
 			Name:     "Pipeline Definition",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
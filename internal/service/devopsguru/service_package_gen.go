@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceNotificationChannel,
 			TypeName: "aws_devopsguru_notification_channel",
 			Name:     "Notification Channel",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceResourceCollection,
 			TypeName: "aws_devopsguru_resource_collection",
 			Name:     "Resource Collection",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,21 +37,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceEventSourcesConfig,
 			TypeName: "aws_devopsguru_event_sources_config",
 			Name:     "Event Sources Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceNotificationChannel,
 			TypeName: "aws_devopsguru_notification_channel",
 			Name:     "Notification Channel",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceResourceCollection,
 			TypeName: "aws_devopsguru_resource_collection",
 			Name:     "Resource Collection",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceServiceIntegration,
 			TypeName: "aws_devopsguru_service_integration",
 			Name:     "Service Integration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
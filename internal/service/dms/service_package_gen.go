@@ -31,6 +31,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrCertificateARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceEndpoint,
@@ -39,6 +40,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "endpoint_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceReplicationInstance,
@@ -47,6 +49,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_instance_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceReplicationSubnetGroup,
@@ -55,6 +58,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_subnet_group_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceReplicationTask,
@@ -63,6 +67,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_task_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -76,6 +81,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrCertificateARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceEndpoint,
@@ -84,6 +90,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "endpoint_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceEventSubscription,
@@ -92,6 +99,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationConfig,
@@ -100,6 +108,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationInstance,
@@ -108,6 +117,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_instance_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationSubnetGroup,
@@ -116,6 +126,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_subnet_group_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationTask,
@@ -124,6 +135,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "replication_task_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceS3Endpoint,
@@ -132,6 +144,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "endpoint_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newTrustResource,
 			TypeName: "aws_directory_service_trust",
 			Name:     "Trust",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -34,6 +35,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceDirectory,
 			TypeName: "aws_directory_service_directory",
 			Name:     "Directory",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -44,6 +46,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceConditionalForwarder,
 			TypeName: "aws_directory_service_conditional_forwarder",
 			Name:     "Conditional Forwarder",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDirectory,
@@ -52,32 +55,38 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLogSubscription,
 			TypeName: "aws_directory_service_log_subscription",
 			Name:     "Log Subscription",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRadiusSettings,
 			TypeName: "aws_directory_service_radius_settings",
 			Name:     "RADIUS Settings",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRegion,
 			TypeName: "aws_directory_service_region",
 			Name:     "Region",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSharedDirectory,
 			TypeName: "aws_directory_service_shared_directory",
 			Name:     "Shared Directory",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSharedDirectoryAccepter,
 			TypeName: "aws_directory_service_shared_directory_accepter",
 			Name:     "Shared Directory Accepter",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -45,6 +45,7 @@ import (
 )
 
 // @SDKResource("aws_instance", name="Instance")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
@@ -2209,6 +2210,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.IsNewResource() || d.HasChange(names.AttrTagsAll) {
+		if err := propagateTagsToInstanceChildren(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): propagating tags to child resources: %s", d.Id(), err)
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -3515,6 +3522,49 @@ func blockDeviceTagsDefined(d *schema.ResourceData) bool {
 	return false
 }
 
+// propagateTagsToInstanceChildren applies the instance's tags to the EBS volumes and network interfaces created with the instance
+// if the provider's default_tags configuration enables propagate_to_children.
+// Volumes and network interfaces whose tags are managed by the instance's configuration, or by other resources, are not tagged.
+func propagateTagsToInstanceChildren(ctx context.Context, d *schema.ResourceData, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if !c.DefaultTagsConfig(ctx).GetPropagateToChildren() {
+		return nil
+	}
+
+	instance, err := findInstanceByID(ctx, c.EC2Client(ctx), d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var arns []string
+	config := d.GetRawConfig()
+
+	if config.GetAttr("volume_tags").IsNull() && isRawConfigBlockEmpty(config.GetAttr("root_block_device")) && isRawConfigBlockEmpty(config.GetAttr("ebs_block_device")) {
+		for _, v := range instance.BlockDeviceMappings {
+			if ebs := v.Ebs; ebs != nil && aws.ToBool(ebs.DeleteOnTermination) {
+				arns = append(arns, c.RegionalARN(ctx, names.EC2, "volume/"+aws.ToString(ebs.VolumeId)))
+			}
+		}
+	}
+
+	if isRawConfigBlockEmpty(config.GetAttr("network_interface")) {
+		for _, v := range instance.NetworkInterfaces {
+			if attachment := v.Attachment; attachment != nil && aws.ToBool(attachment.DeleteOnTermination) {
+				arns = append(arns, c.RegionalARN(ctx, names.EC2, "network-interface/"+aws.ToString(v.NetworkInterfaceId)))
+			}
+		}
+	}
+
+	return tftags.PropagateToChildren(ctx, c.ResourceGroupsTaggingAPIClient(ctx), keyValueTags(ctx, getTagsIn(ctx)), arns...)
+}
+
+// isRawConfigBlockEmpty returns whether a block is absent from the raw configuration.
+func isRawConfigBlockEmpty(v cty.Value) bool {
+	return v.IsNull() || (v.IsKnown() && v.LengthInt() == 0)
+}
+
 func expandInstanceMetadataOptions(l []any) *awstypes.InstanceMetadataOptionsRequest {
 	if len(l) == 0 || l[0] == nil {
 		return nil
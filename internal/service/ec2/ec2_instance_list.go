@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data instanceListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeInstancesInput{
+		Filters: newCustomListFilterList(ctx, data.Filters),
+	}
+	// Terminated instances are not found by the resource's Read handler.
+	if !slices.ContainsFunc(input.Filters, func(v awstypes.Filter) bool {
+		return aws.ToString(v.Name) == "instance-state-name"
+	}) {
+		input.Filters = append(input.Filters, newFilter("instance-state-name", enum.Slice(
+			awstypes.InstanceStateNamePending,
+			awstypes.InstanceStateNameRunning,
+			awstypes.InstanceStateNameShuttingDown,
+			awstypes.InstanceStateNameStopping,
+			awstypes.InstanceStateNameStopped,
+		)))
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeInstancesPages(ctx, conn, &input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, reservation := range page.Reservations {
+				for _, v := range reservation.Instances {
+					id := aws.ToString(v.InstanceId)
+					result := request.NewListResult(ctx)
+					result.DisplayName = id
+					if v, ok := keyValueTags(ctx, v.Tags).Map()["Name"]; ok {
+						result.DisplayName = v
+					}
+
+					if !l.SetResult(ctx, request.IncludeResource, id, &result) {
+						continue
+					}
+
+					if !yield(result) {
+						return false
+					}
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(framework.ListResultError("listing EC2 Instances", err))
+		}
+	}
+}
+
+type instanceListResourceModel struct {
+	Filters []customListFilterModel `tfsdk:"filter"`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -211,6 +212,48 @@ func newCustomFilterListFramework(ctx context.Context, filterSet types.Set) []aw
 	return filters
 }
 
+// customListFiltersBlock returns the list resource config schema for a `filter` block
+// conforming to the pattern of customFiltersSchema.
+func customListFiltersBlock() listschema.Block {
+	return listschema.ListNestedBlock{
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// customListFilterModel represents a single configured list resource filter.
+type customListFilterModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// newCustomListFilterList transforms list resource filters conforming to customListFiltersBlock
+// into a []awstypes.Filter.
+func newCustomListFilterList(ctx context.Context, tfList []customListFilterModel) []awstypes.Filter {
+	var filters []awstypes.Filter
+
+	for _, data := range tfList {
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		if v := fwflex.ExpandFrameworkStringValueList(ctx, data.Values); v != nil {
+			filters = append(filters, newFilter(data.Name.ValueString(), v))
+		}
+	}
+
+	return filters
+}
+
 // newAttributeFilterList takes a flat map of scalar attributes (most
 // likely values extracted from a *schema.ResourceData on an EC2-querying
 // data source) and produces a []*ec2.Filter representing an exact match
@@ -35,6 +35,7 @@ import (
 )
 
 // @SDKResource("aws_security_group", name="Security Group")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &securityGroupListResource{}, nil
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(),
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data securityGroupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSecurityGroupsInput{
+		Filters: newCustomListFilterList(ctx, data.Filters),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeSecurityGroupsPages(ctx, conn, &input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+			for _, v := range page.SecurityGroups {
+				id := aws.ToString(v.GroupId)
+				result := request.NewListResult(ctx)
+				result.DisplayName = aws.ToString(v.GroupName)
+
+				if !l.SetResult(ctx, request.IncludeResource, id, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(framework.ListResultError("listing EC2 Security Groups", err))
+		}
+	}
+}
+
+type securityGroupListResourceModel struct {
+	Filters []customListFilterModel `tfsdk:"filter"`
+}
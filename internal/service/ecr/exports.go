@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+// Exports for use in other modules.
+var (
+	DecodeAuthorizationToken = decodeAuthorizationToken
+)
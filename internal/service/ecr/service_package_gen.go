@@ -14,17 +14,30 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newEphemeralAuthorizationToken,
+			TypeName: "aws_ecr_authorization_token",
+			Name:     "Authorization Token",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
 			Factory:  newLifecyclePolicyDocumentDataSource,
 			TypeName: "aws_ecr_lifecycle_policy_document",
 			Name:     "Lifecycle Policy Document",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newRepositoriesDataSource,
 			TypeName: "aws_ecr_repositories",
 			Name:     "Repositories",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,6 +48,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAccountSettingResource,
 			TypeName: "aws_ecr_account_setting",
 			Name:     "Account Setting",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -45,16 +59,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAuthorizationToken,
 			TypeName: "aws_ecr_authorization_token",
 			Name:     "Authorization Token",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceImage,
 			TypeName: "aws_ecr_image",
 			Name:     "Image",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
 			Name:     "Pull Through Cache Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRepository,
@@ -63,11 +80,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRepositoryCreationTemplate,
 			TypeName: "aws_ecr_repository_creation_template",
 			Name:     "Repository Creation Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -78,26 +97,31 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceLifecyclePolicy,
 			TypeName: "aws_ecr_lifecycle_policy",
 			Name:     "Lifecycle Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
 			Name:     "Pull Through Cache Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRegistryPolicy,
 			TypeName: "aws_ecr_registry_policy",
 			Name:     "Registry Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRegistryScanningConfiguration,
 			TypeName: "aws_ecr_registry_scanning_configuration",
 			Name:     "Registry Scanning Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationConfiguration,
 			TypeName: "aws_ecr_replication_configuration",
 			Name:     "Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRepository,
@@ -106,16 +130,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRepositoryCreationTemplate,
 			TypeName: "aws_ecr_repository_creation_template",
 			Name:     "Repository Creation Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRepositoryPolicy,
 			TypeName: "aws_ecr_repository_policy",
 			Name:     "Repsitory Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -29,22 +29,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_efs_access_point",
 			Name:     "Access Point",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceAccessPoints,
 			TypeName: "aws_efs_access_points",
 			Name:     "Access Point",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceFileSystem,
 			TypeName: "aws_efs_file_system",
 			Name:     "File System",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceMountTarget,
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -58,11 +62,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBackupPolicy,
 			TypeName: "aws_efs_backup_policy",
 			Name:     "Backup Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFileSystem,
@@ -71,21 +77,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFileSystemPolicy,
 			TypeName: "aws_efs_file_system_policy",
 			Name:     "File System Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceMountTarget,
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationConfiguration,
 			TypeName: "aws_efs_replication_configuration",
 			Name:     "Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamauth"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	authTokenLifetime = 15 * time.Minute
+)
+
+// @EphemeralResource("aws_elasticache_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cache_name": schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"serverless": schema.BoolAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := e.Meta().CredentialsProvider(ctx).Retrieve(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError("retrieving AWS credentials", err.Error())
+
+		return
+	}
+
+	cacheName := data.CacheName.ValueString()
+	signingTime := time.Now().UTC()
+	token, err := buildAuthToken(ctx, cacheName, data.UserID.ValueString(), data.Serverless.ValueBool(), e.Meta().Region(ctx), credentials, signingTime)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating ElastiCache IAM authentication token (%s)", cacheName), err.Error())
+
+		return
+	}
+
+	expiration := signingTime.Add(authTokenLifetime)
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authTokenEphemeralResourceModel struct {
+	CacheName  types.String      `tfsdk:"cache_name"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Serverless types.Bool        `tfsdk:"serverless"`
+	Token      types.String      `tfsdk:"token"`
+	UserID     types.String      `tfsdk:"user_id"`
+}
+
+// buildAuthToken returns an IAM authentication token for the specified replication group or serverless cache and user.
+// See https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/auth-iam.html.
+func buildAuthToken(ctx context.Context, cacheName, userID string, serverless bool, region string, credentials aws.Credentials, signingTime time.Time) (string, error) {
+	query := url.Values{
+		"User": []string{userID},
+	}
+	if serverless {
+		query.Set("ResourceType", "ServerlessCache")
+	}
+
+	return iamauth.BuildConnectToken(ctx, cacheName, query, "elasticache", region, credentials, authTokenLifetime, signingTime)
+}
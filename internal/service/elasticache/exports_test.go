@@ -15,6 +15,7 @@ var (
 	ResourceUserGroup              = resourceUserGroup
 	ResourceUserGroupAssociation   = resourceUserGroupAssociation
 
+	BuildAuthToken                       = buildAuthToken
 	FindCacheClusterByID                 = findCacheClusterByID
 	FindCacheParameterGroup              = findCacheParameterGroup
 	FindCacheParameterGroupByName        = findCacheParameterGroupByName
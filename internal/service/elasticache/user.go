@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -95,6 +96,19 @@ func resourceUser() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"authentication_mode.0.passwords", "passwords"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
 			"passwords": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -103,7 +117,8 @@ func resourceUser() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
 				},
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -144,6 +159,21 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		if input.AuthenticationMode != nil {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		} else {
+			input.Passwords = []string{passwordWO}
+		}
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -251,6 +281,22 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if d.HasChange("password_wo_version") {
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				if input.AuthenticationMode != nil {
+					input.AuthenticationMode.Passwords = []string{passwordWO}
+				} else {
+					input.Passwords = []string{passwordWO}
+				}
+			}
+		}
+
 		_, err := conn.ModifyUser(ctx, input)
 
 		if err != nil {
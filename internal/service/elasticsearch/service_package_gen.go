@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceDomain,
 			TypeName: "aws_elasticsearch_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -41,21 +42,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainPolicy,
 			TypeName: "aws_elasticsearch_domain_policy",
 			Name:     "Domain Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainSAMLOptions,
 			TypeName: "aws_elasticsearch_domain_saml_options",
 			Name:     "Domain SAML Options",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceVPCEndpoint,
 			TypeName: "aws_elasticsearch_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePipeline,
 			TypeName: "aws_elastictranscoder_pipeline",
 			Name:     "Pipeline",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePreset,
 			TypeName: "aws_elastictranscoder_preset",
 			Name:     "Preset",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,6 +48,7 @@ var accountIDPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_elb_service_account", name="Service Account")
+// @Region(overrideEnabled=false)
 func dataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
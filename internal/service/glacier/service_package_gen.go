@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceVaultLock,
 			TypeName: "aws_glacier_vault_lock",
 			Name:     "Vault Lock",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
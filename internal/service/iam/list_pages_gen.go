@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -Paginator=Marker -ListOps=ListGroupsForUser"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -Paginator=Marker -ListOps=ListGroupsForUser,ListRoles"; DO NOT EDIT.
 
 package iam
 
@@ -25,3 +25,19 @@ func listGroupsForUserPages(ctx context.Context, conn *iam.Client, input *iam.Li
 	}
 	return nil
 }
+func listRolesPages(ctx context.Context, conn *iam.Client, input *iam.ListRolesInput, fn func(*iam.ListRolesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListRoles(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.Marker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().IAMClient(ctx)
+
+	input := iam.ListRolesInput{
+		PathPrefix: fwflex.StringFromFramework(ctx, data.PathPrefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listRolesPages(ctx, conn, &input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			for _, v := range page.Roles {
+				name := aws.ToString(v.RoleName)
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, request.IncludeResource, name, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(framework.ListResultError("listing IAM Roles", err))
+		}
+	}
+}
+
+type roleListResourceModel struct {
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
@@ -24,36 +24,43 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceGroupPoliciesExclusive,
 			TypeName: "aws_iam_group_policies_exclusive",
 			Name:     "Group Policies Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceGroupPolicyAttachmentsExclusive,
 			TypeName: "aws_iam_group_policy_attachments_exclusive",
 			Name:     "Group Policy Attachments Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newOrganizationsFeaturesResource,
 			TypeName: "aws_iam_organizations_features",
 			Name:     "Organizations Features",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceRolePoliciesExclusive,
 			TypeName: "aws_iam_role_policies_exclusive",
 			Name:     "Role Policies Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceRolePolicyAttachmentsExclusive,
 			TypeName: "aws_iam_role_policy_attachments_exclusive",
 			Name:     "Role Policy Attachments Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceUserPoliciesExclusive,
 			TypeName: "aws_iam_user_policies_exclusive",
 			Name:     "User Policies Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceUserPolicyAttachmentsExclusive,
 			TypeName: "aws_iam_user_policy_attachments_exclusive",
 			Name:     "User Policy Attachments Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -64,90 +71,118 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAccessKeys,
 			TypeName: "aws_iam_access_keys",
 			Name:     "Access Keys",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceAccountAlias,
 			TypeName: "aws_iam_account_alias",
 			Name:     "Account Alias",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceGroup,
 			TypeName: "aws_iam_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
 			Name:     "Instance Profile",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceInstanceProfiles,
 			TypeName: "aws_iam_instance_profiles",
 			Name:     "Instance Profiles",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceOpenIDConnectProvider,
 			TypeName: "aws_iam_openid_connect_provider",
 			Name:     "OIDC Provider",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourcePolicy,
 			TypeName: "aws_iam_policy",
 			Name:     "Policy",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
 			Name:     "Principal Policy Simulation",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceRole,
 			TypeName: "aws_iam_role",
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceRoles,
 			TypeName: "aws_iam_roles",
 			Name:     "Roles",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
 			Name:     "SAML Provider",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
 			Name:     "Server Certificate",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceSessionContext,
 			TypeName: "aws_iam_session_context",
 			Name:     "Session Context",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceUser,
 			TypeName: "aws_iam_user",
 			Name:     "User",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
 			Name:     "User SSH Key",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceUsers,
 			TypeName: "aws_iam_users",
 			Name:     "Users",
+			Region:   types.ResourceRegionGlobal(),
+		},
+	}
+}
+
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newRoleListResource,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -158,36 +193,43 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccessKey,
 			TypeName: "aws_iam_access_key",
 			Name:     "Access Key",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceAccountAlias,
 			TypeName: "aws_iam_account_alias",
 			Name:     "Account Alias",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceAccountPasswordPolicy,
 			TypeName: "aws_iam_account_password_policy",
 			Name:     "Account Password Policy",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceGroup,
 			TypeName: "aws_iam_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
 			Name:     "Group Membership",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
 			Name:     "Group Policy",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceInstanceProfile,
@@ -197,6 +239,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "InstanceProfile",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceOpenIDConnectProvider,
@@ -206,6 +249,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "OIDCProvider",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourcePolicy,
@@ -215,11 +259,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Policy",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourcePolicyAttachment,
 			TypeName: "aws_iam_policy_attachment",
 			Name:     "Policy Attachment",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceRole,
@@ -229,16 +275,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			Region: types.ResourceRegionGlobal(),
+			Identity: types.GlobalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrName, true),
+			),
 		},
 		{
 			Factory:  resourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
 			Name:     "Role Policy",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceSAMLProvider,
@@ -248,11 +300,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "SAMLProvider",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceSecurityTokenServicePreferences,
 			TypeName: "aws_iam_security_token_service_preferences",
 			Name:     "Security Token Service Preferences",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceServerCertificate,
@@ -262,6 +316,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "ServerCertificate",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceServiceLinkedRole,
@@ -271,16 +326,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "ServiceLinkedRole",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceServiceSpecificCredential,
 			TypeName: "aws_iam_service_specific_credential",
 			Name:     "Service Specific Credential",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceSigningCertificate,
 			TypeName: "aws_iam_signing_certificate",
 			Name:     "Signing Certificate",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUser,
@@ -290,31 +348,37 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "User",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUserGroupMembership,
 			TypeName: "aws_iam_user_group_membership",
 			Name:     "User Group Membership",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
 			Name:     "User Login Profile",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
 			Name:     "User Policy",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
 			Name:     "User SSH Key",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceVirtualMFADevice,
@@ -324,6 +388,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "VirtualMFADevice",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -20,16 +20,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newGroupMembershipsDataSource,
 			TypeName: "aws_identitystore_group_memberships",
 			Name:     "Group Memberships",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newGroupsDataSource,
 			TypeName: "aws_identitystore_groups",
 			Name:     "Groups",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newUsersDataSource,
 			TypeName: "aws_identitystore_users",
 			Name:     "Users",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -44,11 +47,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceGroup,
 			TypeName: "aws_identitystore_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceUser,
 			TypeName: "aws_identitystore_user",
 			Name:     "User",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -59,16 +64,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceGroup,
 			TypeName: "aws_identitystore_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceGroupMembership,
 			TypeName: "aws_identitystore_group_membership",
 			Name:     "Group Membership",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceUser,
 			TypeName: "aws_identitystore_user",
 			Name:     "User",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
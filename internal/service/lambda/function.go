@@ -41,6 +41,7 @@ const (
 )
 
 // @SDKResource("aws_lambda_function", name="Function")
+// @IdentityAttribute("function_name")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
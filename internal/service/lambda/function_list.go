@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @SDKListResource("aws_lambda_function", name="Function")
+func newFunctionListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &functionListResource{}, nil
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().LambdaClient(ctx)
+
+	var input lambda.ListFunctionsInput
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listFunctionsPages(ctx, conn, &input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+			for _, v := range page.Functions {
+				name := aws.ToString(v.FunctionName)
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, request.IncludeResource, name, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(framework.ListResultError("listing Lambda Functions", err))
+		}
+	}
+}
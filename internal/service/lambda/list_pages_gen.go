@@ -0,0 +1,27 @@
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListFunctions -InputPaginator=Marker -OutputPaginator=NextMarker"; DO NOT EDIT.
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+)
+
+func listFunctionsPages(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput, fn func(*lambda.ListFunctionsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListFunctions(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+	return nil
+}
@@ -27,31 +27,37 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceBotLocale,
 			TypeName: "aws_lexv2models_bot_locale",
 			Name:     "Bot Locale",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceBotVersion,
 			TypeName: "aws_lexv2models_bot_version",
 			Name:     "Bot Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceIntent,
 			TypeName: "aws_lexv2models_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlot,
 			TypeName: "aws_lexv2models_slot",
 			Name:     "Slot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlotType,
 			TypeName: "aws_lexv2models_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_lightsail_bucket", name="Bucket")
+// @Region(overrideEnabled=false)
 // @Tags(identifierAttribute="id", resourceType="Bucket")
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
@@ -36,16 +36,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Bucket",
 			},
+			Region: types.ResourceRegionDisabled(),
 		},
 		{
 			Factory:  ResourceBucketAccessKey,
 			TypeName: "aws_lightsail_bucket_access_key",
 			Name:     "Bucket Access Key",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceBucketResourceAccess,
 			TypeName: "aws_lightsail_bucket_resource_access",
 			Name:     "Bucket Resource Access",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceCertificate,
@@ -55,6 +58,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Certificate",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceContainerService,
@@ -64,11 +68,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "ContainerService",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceContainerServiceDeploymentVersion,
 			TypeName: "aws_lightsail_container_service_deployment_version",
 			Name:     "Container Service Deployment Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDatabase,
@@ -78,6 +84,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Database",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDisk,
@@ -87,11 +94,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Disk",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDiskAttachment,
 			TypeName: "aws_lightsail_disk_attachment",
 			Name:     "Disk Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDistribution,
@@ -101,16 +110,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Distribution",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDomain,
 			TypeName: "aws_lightsail_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceDomainEntry,
 			TypeName: "aws_lightsail_domain_entry",
 			Name:     "Domain Entry",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceInstance,
@@ -120,11 +132,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Instance",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceInstancePublicPorts,
 			TypeName: "aws_lightsail_instance_public_ports",
 			Name:     "Instance Public Ports",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceKeyPair,
@@ -134,6 +148,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "KeyPair",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancer,
@@ -143,41 +158,49 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "LB",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancerAttachment,
 			TypeName: "aws_lightsail_lb_attachment",
 			Name:     "Load Balancer Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancerCertificate,
 			TypeName: "aws_lightsail_lb_certificate",
 			Name:     "Load Balancer Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancerCertificateAttachment,
 			TypeName: "aws_lightsail_lb_certificate_attachment",
 			Name:     "Load Balancer Certificate Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancerHTTPSRedirectionPolicy,
 			TypeName: "aws_lightsail_lb_https_redirection_policy",
 			Name:     "Load Balancer HTTPS Redirection Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLoadBalancerStickinessPolicy,
 			TypeName: "aws_lightsail_lb_stickiness_policy",
 			Name:     "Load Balancer Stickiness Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceStaticIP,
 			TypeName: "aws_lightsail_static_ip",
 			Name:     "Static IP",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceStaticIPAttachment,
 			TypeName: "aws_lightsail_static_ip_attachment",
 			Name:     "Static IP Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
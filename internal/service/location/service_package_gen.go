@@ -28,36 +28,43 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceGeofenceCollection,
 			TypeName: "aws_location_geofence_collection",
 			Name:     "Geofence Collection",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceMap,
 			TypeName: "aws_location_map",
 			Name:     "Map",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourcePlaceIndex,
 			TypeName: "aws_location_place_index",
 			Name:     "Place Index",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceRouteCalculator,
 			TypeName: "aws_location_route_calculator",
 			Name:     "Route Calculator",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceTracker,
 			TypeName: "aws_location_tracker",
 			Name:     "Tracker",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceTrackerAssociation,
 			TypeName: "aws_location_tracker_association",
 			Name:     "Tracker Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceTrackerAssociations,
 			TypeName: "aws_location_tracker_associations",
 			Name:     "Tracker Associations",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -71,6 +78,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "collection_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceMap,
@@ -79,6 +87,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "map_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePlaceIndex,
@@ -87,6 +96,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "index_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceRouteCalculator,
@@ -95,6 +105,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "calculator_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceTracker,
@@ -103,11 +114,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "tracker_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceTrackerAssociation,
 			TypeName: "aws_location_tracker_association",
 			Name:     "Tracker Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,8 +63,9 @@ func resourceUser() *schema.Resource {
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
-							Set:       schema.HashString,
-							Sensitive: true,
+							Set:           schema.HashString,
+							Sensitive:     true,
+							ConflictsWith: []string{"password_wo"},
 						},
 						"password_count": {
 							Type:     schema.TypeInt,
@@ -81,6 +83,19 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"authentication_mode.0.passwords"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrUserName: {
@@ -108,6 +123,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" && input.AuthenticationMode != nil {
+		input.AuthenticationMode.Passwords = []string{passwordWO}
+	}
+
 	_, err := conn.CreateUser(ctx, input)
 
 	if err != nil {
@@ -171,6 +197,21 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
 		}
 
+		if d.HasChange("password_wo_version") {
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" && input.AuthenticationMode != nil {
+				input.AuthenticationMode.Passwords = []string{passwordWO}
+			}
+		} else if _, ok := d.GetOk("password_wo_version"); ok {
+			// The write-only password is not being rotated and is unavailable, so leave the authentication mode unchanged.
+			input.AuthenticationMode = nil
+		}
+
 		_, err := conn.UpdateUser(ctx, input)
 
 		if err != nil {
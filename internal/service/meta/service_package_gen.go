@@ -17,41 +17,49 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newARNDataSource,
 			TypeName: "aws_arn",
 			Name:     "ARN",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newDefaultTagsDataSource,
 			TypeName: "aws_default_tags",
 			Name:     "Default Tags",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newIPRangesDataSource,
 			TypeName: "aws_ip_ranges",
 			Name:     "IP Ranges",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newPartitionDataSource,
 			TypeName: "aws_partition",
 			Name:     "Partition",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newRegionDataSource,
 			TypeName: "aws_region",
 			Name:     "Region",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newRegionsDataSource,
 			TypeName: "aws_regions",
 			Name:     "Regions",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newServiceDataSource,
 			TypeName: "aws_service",
 			Name:     "Service",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newServicePrincipalDataSource,
 			TypeName: "aws_service_principal",
 			Name:     "Service Principal",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -39,6 +40,8 @@ import (
 	"golang.org/x/mod/semver"
 )
 
+var ldapServiceAccountPasswordWOPath = cty.GetAttrPath("ldap_server_metadata").IndexInt(0).GetAttr("service_account_password_wo")
+
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
 func resourceBroker() *schema.Resource {
@@ -222,9 +225,22 @@ func resourceBroker() *schema.Resource {
 							Optional: true,
 						},
 						"service_account_password": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
+						},
+						"service_account_password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							ConflictsWith: []string{"ldap_server_metadata.0.service_account_password"},
+							RequiredWith:  []string{"ldap_server_metadata.0.service_account_password_wo_version"},
+						},
+						"service_account_password_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
 						},
 						"service_account_username": {
 							Type:     schema.TypeString,
@@ -429,6 +445,17 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	if v, ok := d.GetOk("ldap_server_metadata"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.LdapServerMetadata = expandLDAPServerMetadata(v.([]any))
+
+		// get write-only value from configuration
+		servicePasswordWO, di := flex.GetWriteOnlyStringValue(d, ldapServiceAccountPasswordWOPath)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if servicePasswordWO != "" {
+			input.LdapServerMetadata.ServiceAccountPassword = aws.String(servicePasswordWO)
+		}
 	}
 	if v, ok := d.GetOk("logs"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.Logs = expandLogs(engineType, v.([]any))
@@ -508,7 +535,11 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		password = v.(string)
 	}
 
-	if err := d.Set("ldap_server_metadata", flattenLDAPServerMetadata(output.LdapServerMetadata, password)); err != nil {
+	ldapServerMetadata := flattenLDAPServerMetadata(output.LdapServerMetadata, password)
+	if v, ok := d.GetOk("ldap_server_metadata.0.service_account_password_wo_version"); ok && len(ldapServerMetadata) > 0 {
+		ldapServerMetadata[0].(map[string]any)["service_account_password_wo_version"] = v
+	}
+	if err := d.Set("ldap_server_metadata", ldapServerMetadata); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
 	}
 
@@ -608,6 +639,30 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		requiresReboot = true
 	}
 
+	if d.HasChange("ldap_server_metadata.0.service_account_password_wo_version") {
+		servicePasswordWO, di := flex.GetWriteOnlyStringValue(d, ldapServiceAccountPasswordWOPath)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input := &mq.UpdateBrokerInput{
+			BrokerId:           aws.String(d.Id()),
+			LdapServerMetadata: expandLDAPServerMetadata(d.Get("ldap_server_metadata").([]any)),
+		}
+		if servicePasswordWO != "" {
+			input.LdapServerMetadata.ServiceAccountPassword = aws.String(servicePasswordWO)
+		}
+
+		_, err := conn.UpdateBroker(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) LDAP server metadata: %s", d.Id(), err)
+		}
+
+		requiresReboot = true
+	}
+
 	if d.HasChange(names.AttrAutoMinorVersionUpgrade) {
 		input := &mq.UpdateBrokerInput{
 			AutoMinorVersionUpgrade: aws.Bool(d.Get(names.AttrAutoMinorVersionUpgrade).(bool)),
@@ -28,21 +28,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLink,
 			TypeName: "aws_oam_link",
 			Name:     "Link",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceLinks,
 			TypeName: "aws_oam_links",
 			Name:     "Links",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceSink,
 			TypeName: "aws_oam_sink",
 			Name:     "Sink",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceSinks,
 			TypeName: "aws_oam_sinks",
 			Name:     "Sinks",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -56,6 +60,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSink,
@@ -64,11 +69,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSinkPolicy,
 			TypeName: "aws_oam_sink_policy",
 			Name:     "Sink Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
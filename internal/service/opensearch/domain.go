@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -154,9 +155,10 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"master_user_password_wo"},
 									},
 								},
 							},
@@ -550,6 +552,18 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"master_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+				RequiredWith:  []string{"advanced_security_options.0.master_user_options.0.master_user_name", "master_user_password_wo_version"},
+			},
+			"master_user_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"master_user_password_wo"},
+			},
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -726,6 +740,17 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]any))
 	}
 
+	// get write-only value from configuration
+	masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if masterUserPasswordWO != "" {
+		setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]any)) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]any)[0].(map[string]any))
 	}
@@ -1042,8 +1067,19 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]any))
 		}
 
-		if d.HasChange("advanced_security_options") {
+		if d.HasChanges("advanced_security_options", "master_user_password_wo_version") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
+
+			// The master user password is sent with every change to the security options.
+			masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if masterUserPasswordWO != "" {
+				setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
@@ -54,6 +54,15 @@ func expandAdvancedSecurityOptions(m []any) *awstypes.AdvancedSecurityOptionsInp
 	return &config
 }
 
+// setMasterUserPassword sets the internal user database master user's password, if a master user is configured.
+func setMasterUserPassword(apiObject *awstypes.AdvancedSecurityOptionsInput, password string) {
+	if apiObject == nil || apiObject.MasterUserOptions == nil {
+		return
+	}
+
+	apiObject.MasterUserOptions.MasterUserPassword = aws.String(password)
+}
+
 func expandAutoTuneOptions(tfMap map[string]any) *awstypes.AutoTuneOptions {
 	if tfMap == nil {
 		return nil
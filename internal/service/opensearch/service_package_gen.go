@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAuthorizeVPCEndpointAccess,
 			TypeName: "aws_opensearch_authorize_vpc_endpoint_access",
 			Name:     "Authorize VPC Endpoint Access",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -34,6 +35,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceDomain,
 			TypeName: "aws_opensearch_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -47,41 +49,49 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainPolicy,
 			TypeName: "aws_opensearch_domain_policy",
 			Name:     "Domain Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainSAMLOptions,
 			TypeName: "aws_opensearch_domain_saml_options",
 			Name:     "Domain SAML Options",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceInboundConnectionAccepter,
 			TypeName: "aws_opensearch_inbound_connection_accepter",
 			Name:     "Inbound Connection Accepter",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceOutboundConnection,
 			TypeName: "aws_opensearch_outbound_connection",
 			Name:     "Outbound Connection",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePackage,
 			TypeName: "aws_opensearch_package",
 			Name:     "Package",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePackageAssociation,
 			TypeName: "aws_opensearch_package_association",
 			Name:     "Package Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceVPCEndpoint,
 			TypeName: "aws_opensearch_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceAccessPolicy,
 			TypeName: "aws_opensearchserverless_access_policy",
 			Name:     "Access Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceCollection,
@@ -28,16 +29,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceLifecyclePolicy,
 			TypeName: "aws_opensearchserverless_lifecycle_policy",
 			Name:     "Lifecycle Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceSecurityConfig,
 			TypeName: "aws_opensearchserverless_security_config",
 			Name:     "Security Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,6 +52,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAccessPolicy,
 			TypeName: "aws_opensearchserverless_access_policy",
 			Name:     "Access Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceCollection,
@@ -56,26 +61,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceLifecyclePolicy,
 			TypeName: "aws_opensearchserverless_lifecycle_policy",
 			Name:     "Lifecycle Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSecurityConfig,
 			TypeName: "aws_opensearchserverless_security_config",
 			Name:     "Security Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSecurityPolicy,
 			TypeName: "aws_opensearchserverless_security_policy",
 			Name:     "Security Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newVPCEndpointResource,
 			TypeName: "aws_opensearchserverless_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -86,11 +96,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityPolicy,
 			TypeName: "aws_opensearchserverless_security_policy",
 			Name:     "Security Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceVPCEndpoint,
 			TypeName: "aws_opensearchserverless_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -27,11 +27,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceKeyAlias,
 			TypeName: "aws_paymentcryptography_key_alias",
 			Name:     "Key Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
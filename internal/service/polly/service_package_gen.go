@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceVoices,
 			TypeName: "aws_polly_voices",
 			Name:     "Voices",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
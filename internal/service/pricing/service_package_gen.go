@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceProduct,
 			TypeName: "aws_pricing_product",
 			Name:     "Product",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
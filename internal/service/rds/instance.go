@@ -2442,6 +2442,17 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) delete: %s", d.Get(names.AttrIdentifier).(string), err)
 	}
 
+	if v := input.FinalDBSnapshotIdentifier; v != nil {
+		if c := meta.(*conns.AWSClient); c.DefaultTagsConfig(ctx).GetPropagateToChildren() {
+			arn := c.RegionalARN(ctx, names.RDS, "snapshot:"+aws.ToString(v))
+			tags := tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]any))
+
+			if err := tftags.PropagateToChildren(ctx, c.ResourceGroupsTaggingAPIClient(ctx), tags, arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "propagating tags to RDS DB Instance (%s) final snapshot (%s): %s", d.Get(names.AttrIdentifier).(string), aws.ToString(v), err)
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceResource,
 			TypeName: "aws_resourcegroups_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
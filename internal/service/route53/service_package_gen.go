@@ -22,11 +22,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newRecordsDataSource,
 			TypeName: "aws_route53_records",
 			Name:     "Records",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newZonesDataSource,
 			TypeName: "aws_route53_zones",
 			Name:     "Zones",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -37,16 +39,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newCIDRCollectionResource,
 			TypeName: "aws_route53_cidr_collection",
 			Name:     "CIDR Collection",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newCIDRLocationResource,
 			TypeName: "aws_route53_cidr_location",
 			Name:     "CIDR Location",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceRecordsExclusive,
 			TypeName: "aws_route53_records_exclusive",
 			Name:     "Records Exclusive",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -57,16 +62,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 			Name:     "Reusable Delegation Set",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
 			Name:     "Traffic Policy Document",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  dataSourceZone,
 			TypeName: "aws_route53_zone",
 			Name:     "Hosted Zone",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -77,6 +85,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 			Name:     "Reusable Delegation Set",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceHealthCheck,
@@ -86,41 +95,49 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "healthcheck",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceHostedZoneDNSSEC,
 			TypeName: "aws_route53_hosted_zone_dnssec",
 			Name:     "Hosted Zone DNSSEC",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceKeySigningKey,
 			TypeName: "aws_route53_key_signing_key",
 			Name:     "Key Signing Key",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceQueryLog,
 			TypeName: "aws_route53_query_log",
 			Name:     "Query Logging Config",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceRecord,
 			TypeName: "aws_route53_record",
 			Name:     "Record",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
 			Name:     "Traffic Policy",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceTrafficPolicyInstance,
 			TypeName: "aws_route53_traffic_policy_instance",
 			Name:     "Traffic Policy Instance",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceVPCAssociationAuthorization,
 			TypeName: "aws_route53_vpc_association_authorization",
 			Name:     "VPC Association Authorization",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceZone,
@@ -130,11 +147,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "zone_id",
 				ResourceType:        "hostedzone",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  resourceZoneAssociation,
 			TypeName: "aws_route53_zone_association",
 			Name:     "Zone Association",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -35,11 +35,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceMetricsDestination,
 			TypeName: "aws_rum_metrics_destination",
 			Name:     "Metrics Destination",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
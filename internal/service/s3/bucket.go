@@ -49,6 +49,7 @@ const (
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
+// @IdentityAttribute("bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
@@ -398,9 +399,13 @@ func resourceBucket() *schema.Resource {
 					return json
 				},
 			},
+			// Per-resource Region override. If not configured, the bucket's Region.
 			names.AttrRegion: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
 			},
 			"replication_configuration": {
 				Type:       schema.TypeList,
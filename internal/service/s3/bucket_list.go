@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrPrefix: listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data bucketListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c := l.Meta()
+	conn := c.S3Client(ctx)
+
+	// Only list general purpose buckets in the provider's configured Region.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(c.Region(ctx)),
+		Prefix:       fwflex.StringFromFramework(ctx, data.Prefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listBucketsPages(ctx, conn, &input, func(page *s3.ListBucketsOutput, lastPage bool) bool {
+			for _, v := range page.Buckets {
+				name := aws.ToString(v.Name)
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, request.IncludeResource, name, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(framework.ListResultError("listing S3 Buckets", err))
+		}
+	}
+}
+
+type bucketListResourceModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+}
@@ -1,6 +1,7 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListBuckets -Paginator=ContinuationToken
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagsFunc=Tags -GetTagsInFunc=getTagsIn -SetTagsOutFunc=setTagsOut
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
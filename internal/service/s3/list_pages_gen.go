@@ -0,0 +1,27 @@
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListBuckets -Paginator=ContinuationToken"; DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func listBucketsPages(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput, fn func(*s3.ListBucketsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListBuckets(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.ContinuationToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.ContinuationToken = output.ContinuationToken
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDirectoryBucketsDataSource,
 			TypeName: "aws_s3_directory_buckets",
 			Name:     "Directory Buckets",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,11 +31,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceBucketLifecycleConfiguration,
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
 			Name:     "Directory Bucket",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -45,11 +48,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCanonicalUserID,
 			TypeName: "aws_canonical_user_id",
 			Name:     "Canonical User ID",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBucket,
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
+			Region:   types.ResourceRegionDisabled(),
 		},
 		{
 			Factory:  dataSourceBucketObject,
@@ -59,16 +64,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "BucketObject",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBucketObjects,
 			TypeName: "aws_s3_bucket_objects",
 			Name:     "Bucket Objects",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBucketPolicy,
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceObject,
@@ -78,11 +86,24 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Object",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceObjects,
 			TypeName: "aws_s3_objects",
 			Name:     "Objects",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newBucketListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -97,51 +118,64 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Region: types.ResourceRegionDefault(),
+			Identity: types.RegionalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrBucket, true),
+			),
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
 			TypeName: "aws_s3_bucket_accelerate_configuration",
 			Name:     "Bucket Accelerate Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketACL,
 			TypeName: "aws_s3_bucket_acl",
 			Name:     "Bucket ACL",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketAnalyticsConfiguration,
 			TypeName: "aws_s3_bucket_analytics_configuration",
 			Name:     "Bucket Analytics Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketCorsConfiguration,
 			TypeName: "aws_s3_bucket_cors_configuration",
 			Name:     "Bucket CORS Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketIntelligentTieringConfiguration,
 			TypeName: "aws_s3_bucket_intelligent_tiering_configuration",
 			Name:     "Bucket Intelligent-Tiering Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketInventory,
 			TypeName: "aws_s3_bucket_inventory",
 			Name:     "Bucket Inventory",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketLogging,
 			TypeName: "aws_s3_bucket_logging",
 			Name:     "Bucket Logging",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketMetric,
 			TypeName: "aws_s3_bucket_metric",
 			Name:     "Bucket Metric",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketNotification,
 			TypeName: "aws_s3_bucket_notification",
 			Name:     "Bucket Notification",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketObject,
@@ -151,51 +185,61 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "BucketObject",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketObjectLockConfiguration,
 			TypeName: "aws_s3_bucket_object_lock_configuration",
 			Name:     "Bucket Object Lock Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketOwnershipControls,
 			TypeName: "aws_s3_bucket_ownership_controls",
 			Name:     "Bucket Ownership Controls",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketPublicAccessBlock,
 			TypeName: "aws_s3_bucket_public_access_block",
 			Name:     "Bucket Public Access Block",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketReplicationConfiguration,
 			TypeName: "aws_s3_bucket_replication_configuration",
 			Name:     "Bucket Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketRequestPaymentConfiguration,
 			TypeName: "aws_s3_bucket_request_payment_configuration",
 			Name:     "Bucket Request Payment Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketServerSideEncryptionConfiguration,
 			TypeName: "aws_s3_bucket_server_side_encryption_configuration",
 			Name:     "Bucket Server-side Encryption Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketVersioning,
 			TypeName: "aws_s3_bucket_versioning",
 			Name:     "Bucket Versioning",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketWebsiteConfiguration,
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceObject,
@@ -205,6 +249,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Object",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceObjectCopy,
@@ -214,6 +259,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "ObjectCopy",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSchedule,
 			TypeName: "aws_scheduler_schedule",
 			Name:     "Schedule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceScheduleGroup,
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
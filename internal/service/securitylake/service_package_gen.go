@@ -24,11 +24,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAWSLogSourceResource,
 			TypeName: "aws_securitylake_aws_log_source",
 			Name:     "AWS Log Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newCustomLogSourceResource,
 			TypeName: "aws_securitylake_custom_log_source",
 			Name:     "Custom Log Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataLakeResource,
@@ -37,6 +39,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newSubscriberResource,
@@ -45,11 +48,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newSubscriberNotificationResource,
 			TypeName: "aws_securitylake_subscriber_notification",
 			Name:     "Subscriber Notification",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
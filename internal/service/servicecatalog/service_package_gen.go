@@ -28,33 +28,39 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceConstraint,
 			TypeName: "aws_servicecatalog_constraint",
 			Name:     "Constraint",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceLaunchPaths,
 			TypeName: "aws_servicecatalog_launch_paths",
 			Name:     "Launch Paths",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourcePortfolio,
 			TypeName: "aws_servicecatalog_portfolio",
 			Name:     "Portfolio",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourcePortfolioConstraints,
 			TypeName: "aws_servicecatalog_portfolio_constraints",
 			Name:     "Portfolio Constraints",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceProduct,
 			TypeName: "aws_servicecatalog_product",
 			Name:     "Product",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceProvisioningArtifacts,
 			TypeName: "aws_servicecatalog_provisioning_artifacts",
 			Name:     "Provisioning Artifacts",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -65,69 +71,82 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBudgetResourceAssociation,
 			TypeName: "aws_servicecatalog_budget_resource_association",
 			Name:     "Budget Resource Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceConstraint,
 			TypeName: "aws_servicecatalog_constraint",
 			Name:     "Constraint",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceOrganizationsAccess,
 			TypeName: "aws_servicecatalog_organizations_access",
 			Name:     "Organizations Access",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePortfolio,
 			TypeName: "aws_servicecatalog_portfolio",
 			Name:     "Portfolio",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePortfolioShare,
 			TypeName: "aws_servicecatalog_portfolio_share",
 			Name:     "Portfolio Share",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePrincipalPortfolioAssociation,
 			TypeName: "aws_servicecatalog_principal_portfolio_association",
 			Name:     "Principal Portfolio Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProduct,
 			TypeName: "aws_servicecatalog_product",
 			Name:     "Product",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProductPortfolioAssociation,
 			TypeName: "aws_servicecatalog_product_portfolio_association",
 			Name:     "Product Portfolio Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProvisionedProduct,
 			TypeName: "aws_servicecatalog_provisioned_product",
 			Name:     "Provisioned Product",
 			Tags:     &types.ServicePackageResourceTags{},
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProvisioningArtifact,
 			TypeName: "aws_servicecatalog_provisioning_artifact",
 			Name:     "Provisioning Artifact",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceServiceAction,
 			TypeName: "aws_servicecatalog_service_action",
 			Name:     "Service Action",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceTagOption,
 			TypeName: "aws_servicecatalog_tag_option",
 			Name:     "Tag Option",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceTagOptionResourceAssociation,
 			TypeName: "aws_servicecatalog_tag_option_resource_association",
 			Name:     "Tag Option Resource Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
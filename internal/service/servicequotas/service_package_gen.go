@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceTemplates,
 			TypeName: "aws_servicequotas_templates",
 			Name:     "Templates",
+			Region:   types.ResourceRegionDisabled(),
 		},
 	}
 }
@@ -30,11 +31,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceTemplate,
 			TypeName: "aws_servicequotas_template",
 			Name:     "Template",
+			Region:   types.ResourceRegionDisabled(),
 		},
 		{
 			Factory:  newResourceTemplateAssociation,
 			TypeName: "aws_servicequotas_template_association",
 			Name:     "Template Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -45,11 +48,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceService,
 			TypeName: "aws_servicequotas_service",
 			Name:     "Service",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 			Name:     "Service Quota",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -60,6 +65,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 			Name:     "Service Quota",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
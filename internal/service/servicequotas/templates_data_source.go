@@ -20,6 +20,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_servicequotas_templates", name="Templates")
+// @Region(overrideEnabled=false)
 func newDataSourceTemplates(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &dataSourceTemplates{}, nil
 }
@@ -22,6 +22,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceProtection,
 			TypeName: "aws_shield_protection",
 			Name:     "Protection",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -32,26 +33,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newApplicationLayerAutomaticResponseResource,
 			TypeName: "aws_shield_application_layer_automatic_response",
 			Name:     "Application Layer Automatic Response",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newDRTAccessLogBucketAssociationResource,
 			TypeName: "aws_shield_drt_access_log_bucket_association",
 			Name:     "DRT Log Bucket Association",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newDRTAccessRoleARNAssociationResource,
 			TypeName: "aws_shield_drt_access_role_arn_association",
 			Name:     "DRT Role ARN Association",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newProactiveEngagementResource,
 			TypeName: "aws_shield_proactive_engagement",
 			Name:     "Proactive Engagement",
+			Region:   types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  newResourceSubscription,
 			TypeName: "aws_shield_subscription",
 			Name:     "Subscription",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -69,6 +75,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  ResourceProtectionGroup,
@@ -77,11 +84,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "protection_group_arn",
 			},
+			Region: types.ResourceRegionGlobal(),
 		},
 		{
 			Factory:  ResourceProtectionHealthCheckAssociation,
 			TypeName: "aws_shield_protection_health_check_association",
 			Name:     "Protection Health Check Association",
+			Region:   types.ResourceRegionGlobal(),
 		},
 	}
 }
@@ -238,6 +238,7 @@ var (
 )
 
 // @SDKResource("aws_sns_topic", name="Topic")
+// @ArnIdentity
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="map[string]string")
 func resourceTopic() *schema.Resource {
@@ -27,6 +27,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "manager_arn",
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 	}
 }
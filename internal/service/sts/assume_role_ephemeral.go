@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newEphemeralAssumeRole(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrARN: schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Optional:   true,
+		},
+		names.AttrExternalID: schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 1224),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), ""),
+			},
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType: fwtypes.SetOfARNType,
+			Optional:   true,
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"session_name": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+			},
+		},
+		"source_identity": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+			},
+		},
+		names.AttrTags: schema.MapAttribute{
+			CustomType: fwtypes.MapOfStringType,
+			Optional:   true,
+		},
+		"transitive_tag_keys": schema.SetAttribute{
+			CustomType: fwtypes.SetOfStringType,
+			Optional:   true,
+		},
+	}
+	maps.Copy(attributes, credentialsSchemaAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	if data.SessionName.IsNull() || data.SessionName.IsUnknown() {
+		data.SessionName = fwflex.StringValueToFramework(ctx, sdkid.UniqueId())
+	}
+
+	input := sts.AssumeRoleInput{
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:        expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		RoleArn:           fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.SessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		Tags:              expandTags(ctx, data.Tags),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	durationSeconds, diags := expandDurationSeconds(data.Duration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DurationSeconds = durationSeconds
+
+	output, err := conn.AssumeRole(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(data.credentialsModel.flatten(ctx, output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.ARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleData struct {
+	credentialsModel
+	ARN               types.String         `tfsdk:"arn"`
+	AssumedRoleID     types.String         `tfsdk:"assumed_role_id"`
+	Duration          timetypes.GoDuration `tfsdk:"duration"`
+	ExternalID        types.String         `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy    `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN     `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN          `tfsdk:"role_arn"`
+	SessionName       types.String         `tfsdk:"session_name"`
+	SourceIdentity    types.String         `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString  `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString  `tfsdk:"transitive_tag_keys"`
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// credentialsSchemaAttributes returns the schema attributes common to all ephemeral resources returning temporary credentials.
+func credentialsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"expiration": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"session_token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func (m *credentialsModel) flatten(ctx context.Context, credentials *awstypes.Credentials) diag.Diagnostics {
+	return fwflex.Flatten(ctx, credentials, m)
+}
+
+func expandPolicyDescriptorTypes(ctx context.Context, tfSet fwtypes.SetOfARN) []awstypes.PolicyDescriptorType {
+	var apiObjects []awstypes.PolicyDescriptorType
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, tfSet) {
+		apiObjects = append(apiObjects, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTags(ctx context.Context, tfMap fwtypes.MapOfString) []awstypes.Tag {
+	var apiObjects []awstypes.Tag
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, tfMap) {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// expandDurationSeconds returns the specified duration in seconds, or nil if no duration is configured.
+func expandDurationSeconds(v timetypes.GoDuration) (*int32, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	duration, diags := v.ValueGoDuration()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return aws.Int32(int32(duration / time.Second)), diags
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameFederationToken = "Ephemeral Resource Federation Token"
+)
+
+// @EphemeralResource("aws_sts_federation_token", name="Federation Token")
+func newEphemeralFederationToken(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralFederationToken{}, nil
+}
+
+type ephemeralFederationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralFederationToken) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrARN: schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Optional:   true,
+		},
+		"federated_user_id": schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrName: schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 32),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@-]*$`), ""),
+			},
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType: fwtypes.SetOfARNType,
+			Optional:   true,
+		},
+		names.AttrTags: schema.MapAttribute{
+			CustomType: fwtypes.MapOfStringType,
+			Optional:   true,
+		},
+	}
+	maps.Copy(attributes, credentialsSchemaAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *ephemeralFederationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epFederationTokenData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	input := sts.GetFederationTokenInput{
+		Name:       fwflex.StringFromFramework(ctx, data.Name),
+		Policy:     fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns: expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		Tags:       expandTags(ctx, data.Tags),
+	}
+
+	durationSeconds, diags := expandDurationSeconds(data.Duration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DurationSeconds = durationSeconds
+
+	output, err := conn.GetFederationToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameFederationToken, data.Name.ValueString(), err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(data.credentialsModel.flatten(ctx, output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := output.FederatedUser; v != nil {
+		data.ARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.FederatedUserID = fwflex.StringToFramework(ctx, v.FederatedUserId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epFederationTokenData struct {
+	credentialsModel
+	ARN             types.String         `tfsdk:"arn"`
+	Duration        timetypes.GoDuration `tfsdk:"duration"`
+	FederatedUserID types.String         `tfsdk:"federated_user_id"`
+	Name            types.String         `tfsdk:"name"`
+	Policy          fwtypes.IAMPolicy    `tfsdk:"policy"`
+	PolicyARNs      fwtypes.SetOfARN     `tfsdk:"policy_arns"`
+	Tags            fwtypes.MapOfString  `tfsdk:"tags"`
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSessionToken = "Ephemeral Resource Session Token"
+)
+
+// @EphemeralResource("aws_sts_session_token", name="Session Token")
+func newEphemeralSessionToken(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSessionToken{}, nil
+}
+
+type ephemeralSessionToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSessionToken) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: timetypes.GoDurationType{},
+			Optional:   true,
+		},
+		"serial_number": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(9, 256),
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=/:,.@-]*$`), ""),
+			},
+		},
+		"token_code": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{6}$`), "must be a six-digit numeric code"),
+				stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+			},
+		},
+	}
+	maps.Copy(attributes, credentialsSchemaAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *ephemeralSessionToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epSessionTokenData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	input := sts.GetSessionTokenInput{
+		SerialNumber: fwflex.StringFromFramework(ctx, data.SerialNumber),
+		TokenCode:    fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+
+	durationSeconds, diags := expandDurationSeconds(data.Duration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DurationSeconds = durationSeconds
+
+	output, err := conn.GetSessionToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameSessionToken, "", err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(data.credentialsModel.flatten(ctx, output.Credentials)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epSessionTokenData struct {
+	credentialsModel
+	Duration     timetypes.GoDuration `tfsdk:"duration"`
+	SerialNumber types.String         `tfsdk:"serial_number"`
+	TokenCode    types.String         `tfsdk:"token_code"`
+}
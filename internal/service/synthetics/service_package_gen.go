@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceRuntimeVersion,
 			TypeName: "aws_synthetics_runtime_version",
 			Name:     "Runtime Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceRuntimeVersions,
 			TypeName: "aws_synthetics_runtime_versions",
 			Name:     "Runtime Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -46,6 +48,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceGroup,
@@ -54,11 +57,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceGroupAssociation,
 			TypeName: "aws_synthetics_group_association",
 			Name:     "Group Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -53,6 +53,8 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// PropagateToChildren indicates that resources' tags are applied to the child resources they create implicitly.
+	PropagateToChildren bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -76,6 +78,15 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// GetPropagateToChildren is convenience method that returns the DefaultConfig's PropagateToChildren, if any
+func (dc *DefaultConfig) GetPropagateToChildren() bool {
+	if dc == nil {
+		return false
+	}
+
+	return dc.PropagateToChildren
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	PolicyEnforcementModeError = "error"
+	PolicyEnforcementModeWarn  = "warn"
+)
+
+// PolicyEnforcementModes returns the valid tag policy enforcement modes.
+func PolicyEnforcementModes() []string {
+	return []string{
+		PolicyEnforcementModeError,
+		PolicyEnforcementModeWarn,
+	}
+}
+
+// PolicyConfig contains the rules that resource tags must comply with.
+type PolicyConfig struct {
+	EnforcementMode string
+	Rules           []PolicyRule
+}
+
+// PolicyRule is a single tag policy rule.
+type PolicyRule struct {
+	// Key is the tag key, in the capitalization required if EnforceKeyCase is set.
+	Key string
+	// Required indicates that the tag must be present.
+	Required bool
+	// AllowedValues holds the permitted tag values. A value ending in `*` matches any value with that prefix.
+	// An empty list permits any value.
+	AllowedValues []string
+	// EnforceKeyCase indicates that the tag key must exactly match Key.
+	// Otherwise the tag key is matched case-insensitively.
+	EnforceKeyCase bool
+}
+
+// PolicyViolation describes a tag that does not comply with a tag policy.
+type PolicyViolation struct {
+	// Key is the key of the non-compliant tag, or empty if a required tag is missing.
+	Key     string
+	Message string
+}
+
+// WarnOnly returns whether violations of the tag policy are reported as warnings rather than errors.
+func (pc *PolicyConfig) WarnOnly() bool {
+	if pc == nil {
+		return false
+	}
+
+	return pc.EnforcementMode == PolicyEnforcementModeWarn
+}
+
+// Evaluate returns the tag policy violations for the specified tags.
+func (pc *PolicyConfig) Evaluate(tags KeyValueTags) []PolicyViolation {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, rule := range pc.Rules {
+		var found bool
+
+		for _, k := range keys {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key && rule.EnforceKeyCase {
+				violations = append(violations, PolicyViolation{
+					Key:     k,
+					Message: fmt.Sprintf("tag key (%s) does not match the capitalization required by the tag policy (%s)", k, rule.Key),
+				})
+				continue
+			}
+
+			found = true
+
+			if v := tags.KeyValue(k); v != nil && !rule.allowsValue(*v) {
+				violations = append(violations, PolicyViolation{
+					Key:     k,
+					Message: fmt.Sprintf("tag (%s) value (%s) is not one of the values allowed by the tag policy: %s", k, *v, strings.Join(rule.AllowedValues, ", ")),
+				})
+			}
+		}
+
+		if rule.Required && !found {
+			violations = append(violations, PolicyViolation{
+				Message: fmt.Sprintf("tag (%s) is required by the tag policy", rule.Key),
+			})
+		}
+	}
+
+	return violations
+}
+
+func (rule PolicyRule) allowsValue(v string) bool {
+	if len(rule.AllowedValues) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(rule.AllowedValues, func(allowed string) bool {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			return strings.HasPrefix(v, prefix)
+		}
+
+		return v == allowed
+	})
+}
+
+// PolicyRulesFromOrganizationsPolicy returns the tag policy rules defined in an AWS Organizations tag policy document.
+// A tag's `tag_key` sets the required capitalization, `tag_value` the allowed values
+// and any `report_required_tag_for` makes the tag required.
+// Only the `@@assign` value-setting operator is supported.
+func PolicyRulesFromOrganizationsPolicy(document string) ([]PolicyRule, error) {
+	type assignOperator[T any] struct {
+		Assign T `json:"@@assign"`
+	}
+	type tagPolicy struct {
+		TagKey               *assignOperator[string]   `json:"tag_key"`
+		TagValue             *assignOperator[[]string] `json:"tag_value"`
+		ReportRequiredTagFor *assignOperator[[]string] `json:"report_required_tag_for"`
+	}
+	var policy struct {
+		Tags map[string]tagPolicy `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("decoding tag policy document: %w", err)
+	}
+
+	rules := make([]PolicyRule, 0, len(policy.Tags))
+
+	for name, tag := range policy.Tags {
+		rule := PolicyRule{
+			Key: name,
+		}
+
+		if v := tag.TagKey; v != nil && v.Assign != "" {
+			if !strings.EqualFold(v.Assign, name) {
+				return nil, fmt.Errorf("tag policy tag (%s) tag_key (%s) does not match the tag name", name, v.Assign)
+			}
+
+			rule.Key = v.Assign
+			rule.EnforceKeyCase = true
+		}
+
+		if v := tag.TagValue; v != nil {
+			rule.AllowedValues = v.Assign
+		}
+
+		if v := tag.ReportRequiredTagFor; v != nil && len(v.Assign) > 0 {
+			rule.Required = true
+		}
+
+		rules = append(rules, rule)
+	}
+
+	slices.SortFunc(rules, func(a, b PolicyRule) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return rules, nil
+}
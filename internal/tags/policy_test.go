@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigEvaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		config *PolicyConfig
+		tags   KeyValueTags
+		want   []PolicyViolation
+	}{
+		{
+			name: "nil",
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "compliant",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true, AllowedValues: []string{"100", "2*"}, EnforceKeyCase: true},
+					{Key: "Owner"},
+				},
+			},
+			tags: New(ctx, map[string]string{"CostCenter": "200", "owner": "team"}),
+		},
+		{
+			name: "required missing",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true},
+				},
+			},
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+			want: []PolicyViolation{
+				{Message: "tag (CostCenter) is required by the tag policy"},
+			},
+		},
+		{
+			name: "required case-insensitive",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true},
+				},
+			},
+			tags: New(ctx, map[string]string{"costcenter": "100"}),
+		},
+		{
+			name: "key case",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true, EnforceKeyCase: true},
+				},
+			},
+			tags: New(ctx, map[string]string{"costcenter": "100"}),
+			want: []PolicyViolation{
+				{Key: "costcenter", Message: "tag key (costcenter) does not match the capitalization required by the tag policy (CostCenter)"},
+				{Message: "tag (CostCenter) is required by the tag policy"},
+			},
+		},
+		{
+			name: "value not allowed",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", AllowedValues: []string{"100", "2*"}},
+				},
+			},
+			tags: New(ctx, map[string]string{"CostCenter": "300"}),
+			want: []PolicyViolation{
+				{Key: "CostCenter", Message: "tag (CostCenter) value (300) is not one of the values allowed by the tag policy: 100, 2*"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Evaluate(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyRulesFromOrganizationsPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		document  string
+		want      []PolicyRule
+		wantError bool
+	}{
+		{
+			name:     "empty",
+			document: `{}`,
+			want:     []PolicyRule{},
+		},
+		{
+			name: "tags",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "report_required_tag_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {
+      "tag_value": {"@@assign": ["team1", "team2"]}
+    }
+  }
+}`,
+			want: []PolicyRule{
+				{Key: "CostCenter", Required: true, AllowedValues: []string{"100", "200*"}, EnforceKeyCase: true},
+				{Key: "owner", AllowedValues: []string{"team1", "team2"}},
+			},
+		},
+		{
+			name:      "tag_key mismatch",
+			document:  `{"tags": {"costcenter": {"tag_key": {"@@assign": "Owner"}}}}`,
+			wantError: true,
+		},
+		{
+			name:      "invalid JSON",
+			document:  `{"tags":`,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyRulesFromOrganizationsPolicy(testCase.document)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("PolicyRulesFromOrganizationsPolicy() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
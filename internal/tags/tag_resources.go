@@ -4,13 +4,23 @@
 package tags
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 )
 
 const (
 	// Separator used in resource identifiers
 	resourceIDSeparator = `,`
+
+	// Maximum number of resources tagged by a single Resource Groups Tagging API TagResources call
+	tagResourcesBatchSize = 20
 )
 
 // GetResourceID parses a given resource identifier for tag identifier and tag key.
@@ -31,3 +41,45 @@ func SetResourceID(identifier string, key string) string {
 
 	return resourceID
 }
+
+// TagResources applies tags to the resources with the specified ARNs using the Resource Groups Tagging API.
+func TagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, tags KeyValueTags) error {
+	tags = tags.IgnoreAWS()
+
+	if len(arns) == 0 || len(tags) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for chunk := range slices.Chunk(arns, tagResourcesBatchSize) {
+		input := resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: chunk,
+			Tags:            tags.Map(),
+		}
+
+		output, err := conn.TagResources(ctx, &input)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tagging resources (%s): %w", strings.Join(chunk, ", "), err))
+			continue
+		}
+
+		for _, arn := range slices.Sorted(maps.Keys(output.FailedResourcesMap)) {
+			v := output.FailedResourcesMap[arn]
+			errs = append(errs, fmt.Errorf("tagging resource (%s): %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// PropagateToChildren applies tags to the child resources with the specified ARNs
+// if the provider's default_tags configuration enables propagate_to_children.
+func PropagateToChildren(ctx context.Context, conn *resourcegroupstaggingapi.Client, tags KeyValueTags, arns ...string) error {
+	if inContext, ok := FromContext(ctx); !ok || !inContext.DefaultConfig.GetPropagateToChildren() {
+		return nil
+	}
+
+	return TagResources(ctx, conn, arns, tags)
+}
@@ -4,6 +4,7 @@
 package tags
 
 import (
+	"context"
 	"testing"
 )
 
@@ -111,3 +112,25 @@ func TestSetResourceId(t *testing.T) {
 		})
 	}
 }
+
+func TestPropagateToChildrenDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{"key1": "value1"})
+
+	for name, ctx := range map[string]context.Context{
+		"no tagging context":      ctx,
+		"no default tags":         NewContext(ctx, nil, nil),
+		"propagation not enabled": NewContext(ctx, &DefaultConfig{Tags: tags}, nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// No API client is required as no resources are tagged.
+			if err := PropagateToChildren(ctx, nil, tags, "arn:aws:ec2:us-west-2:123456789012:volume/vol-12345678"); err != nil { //lintignore:AWSAT003,AWSAT005
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
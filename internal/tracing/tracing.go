@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	scopeName   = "github.com/hashicorp/terraform-provider-aws"
+	serviceName = "terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrPhase          = attribute.Key("tf_aws.phase")
+	AttrResourceType   = attribute.Key("tf_aws.resource_type")
+	AttrRetryCount     = attribute.Key("tf_aws.retry_count")
+	AttrServicePackage = attribute.Key("tf_aws.service_package")
+)
+
+var enabled atomic.Bool
+
+// Enabled returns whether trace export has been configured.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Configure sets the global OpenTelemetry TracerProvider to one exporting spans over OTLP
+// if trace export is configured using the standard OTEL_* environment variables.
+// The returned function flushes any buffered spans and must be called before the process exits.
+func Configure(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !exportConfigured(os.Getenv) {
+		return noop, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch protocol := otlpProtocol(os.Getenv); protocol {
+	case "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return noop, fmt.Errorf("unsupported OTLP protocol: %q", protocol)
+	}
+
+	if err != nil {
+		return noop, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	// Attributes from OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME take precedence.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version.ProviderVersion),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+
+	if err != nil {
+		return noop, fmt.Errorf("creating OpenTelemetry resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+
+	return tp.Shutdown, nil
+}
+
+// exportConfigured returns whether the environment requests OTLP trace export.
+func exportConfigured(getenv func(string) string) bool {
+	if strings.EqualFold(getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+
+	switch v := getenv("OTEL_TRACES_EXPORTER"); v {
+	case "otlp":
+		return true
+	case "":
+		return getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+	default:
+		// "none" or an exporter that isn't supported.
+		return false
+	}
+}
+
+// otlpProtocol returns the OTLP transport protocol used for trace export.
+func otlpProtocol(getenv func(string) string) string {
+	if v := getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"); v != "" {
+		return v
+	}
+
+	if v := getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); v != "" {
+		return v
+	}
+
+	return "http/protobuf"
+}
+
+// Tracer returns the provider's Tracer from the global TracerProvider.
+func Tracer() trace.Tracer {
+	return tracer(otel.GetTracerProvider())
+}
+
+func tracer(tp trace.TracerProvider) trace.Tracer {
+	return tp.Tracer(scopeName, trace.WithInstrumentationVersion(version.ProviderVersion))
+}
+
+// StartCRUDSpan starts a span for a resource's CRUD handler, e.g. "aws_subnet.create".
+func StartCRUDSpan(ctx context.Context, servicePackageName, typeName, phase string) (context.Context, trace.Span) {
+	return startCRUDSpan(ctx, Tracer(), servicePackageName, typeName, phase)
+}
+
+func startCRUDSpan(ctx context.Context, tracer trace.Tracer, servicePackageName, typeName, phase string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, typeName+"."+phase,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			AttrPhase.String(phase),
+			AttrResourceType.String(typeName),
+			AttrServicePackage.String(servicePackageName),
+		),
+	)
+}
+
+// EndSpan ends the specified span, recording any error.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
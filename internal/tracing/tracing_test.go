@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExportConfigured(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		env      map[string]string
+		expected bool
+	}{
+		"no environment": {
+			expected: false,
+		},
+		"endpoint": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			expected: true,
+		},
+		"traces endpoint": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"},
+			expected: true,
+		},
+		"otlp exporter": {
+			env:      map[string]string{"OTEL_TRACES_EXPORTER": "otlp"},
+			expected: true,
+		},
+		"none exporter": {
+			env:      map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"},
+			expected: false,
+		},
+		"SDK disabled": {
+			env:      map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_TRACES_EXPORTER": "otlp"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(k string) string { return testCase.env[k] }
+
+			if got, want := exportConfigured(getenv), testCase.expected; got != want {
+				t.Errorf("exportConfigured = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOTLPProtocol(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		env      map[string]string
+		expected string
+	}{
+		"default": {
+			expected: "http/protobuf",
+		},
+		"protocol": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc"},
+			expected: "grpc",
+		},
+		"traces protocol": {
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc", "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "http/protobuf"},
+			expected: "http/protobuf",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(k string) string { return testCase.env[k] }
+
+			if got, want := otlpProtocol(getenv), testCase.expected; got != want {
+				t.Errorf("otlpProtocol = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCRUDSpan(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err        error
+		wantStatus codes.Code
+	}{
+		"success": {
+			wantStatus: codes.Unset,
+		},
+		"error": {
+			err:        errors.New("creating Subnet"),
+			wantStatus: codes.Error,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			_, span := startCRUDSpan(context.Background(), tracer(tp), "ec2", "aws_subnet", "create")
+			EndSpan(span, testCase.err)
+
+			spans := exporter.GetSpans()
+			if got, want := len(spans), 1; got != want {
+				t.Fatalf("number of spans = %d, want %d", got, want)
+			}
+
+			got := spans[0]
+			if got, want := got.Name, "aws_subnet.create"; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+			if got, want := got.Status.Code, testCase.wantStatus; got != want {
+				t.Errorf("Status = %s, want %s", got, want)
+			}
+
+			attributes := attribute.NewSet(got.Attributes...)
+			for k, want := range map[attribute.Key]string{
+				AttrPhase:          "create",
+				AttrResourceType:   "aws_subnet",
+				AttrServicePackage: "ec2",
+			} {
+				if got, ok := attributes.Value(k); !ok || got.AsString() != want {
+					t.Errorf("attribute %s = %q, want %q", k, got.AsString(), want)
+				}
+			}
+		})
+	}
+}
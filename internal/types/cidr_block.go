@@ -5,9 +5,17 @@ package types
 
 import (
 	"fmt"
+	"math/big"
+	"math/bits"
 	"net"
+	"net/netip"
 )
 
+// SubnetReservedIPAddressCount is the number of IP addresses in each subnet CIDR block that are reserved by AWS:
+// the first four IP addresses and the last IP address.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+const SubnetReservedIPAddressCount = 5
+
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The CIDR block is the CIDR block for the network
@@ -52,3 +60,84 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks overlap.
+// CIDR blocks of different address families never overlap.
+func CIDRBlocksOverlap(cidr1, cidr2 string) (bool, error) {
+	prefix1, err := parseCIDRBlock(cidr1)
+	if err != nil {
+		return false, err
+	}
+	prefix2, err := parseCIDRBlock(cidr2)
+	if err != nil {
+		return false, err
+	}
+
+	return prefix1.Overlaps(prefix2), nil
+}
+
+// SubnetUsableIPAddressCount returns the number of IP addresses in the specified subnet CIDR block
+// that are available for use, taking into account the IP addresses reserved by AWS.
+func SubnetUsableIPAddressCount(cidr string) (*big.Int, error) {
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	n := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+	n.Sub(n, big.NewInt(SubnetReservedIPAddressCount))
+	if n.Sign() < 0 {
+		n.SetInt64(0)
+	}
+
+	return n, nil
+}
+
+// SplitCIDRBlock splits the specified CIDR block into count equally sized CIDR blocks.
+// The CIDR blocks are as large as possible and are returned in address order.
+func SplitCIDRBlock(cidr string, count int) ([]string, error) {
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("count (%d) must be at least 1", count)
+	}
+
+	newBits := bits.Len(uint(count - 1))
+	prefixLen := prefix.Bits() + newBits
+	if prefixLen > prefix.Addr().BitLen() {
+		return nil, fmt.Errorf("%q cannot be split into %d CIDR blocks", cidr, count)
+	}
+
+	// Size of each new CIDR block, in addresses.
+	size := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefixLen))
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	blocks := make([]string, 0, count)
+
+	for i := range count {
+		v := new(big.Int).Mul(size, big.NewInt(int64(i)))
+		v.Add(v, base)
+
+		b := v.FillBytes(make([]byte, prefix.Addr().BitLen()/8))
+		addr, _ := netip.AddrFromSlice(b)
+
+		blocks = append(blocks, netip.PrefixFrom(addr, prefixLen).String())
+	}
+
+	return blocks, nil
+}
+
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	return prefix, nil
+}
@@ -3,7 +3,10 @@
 
 package types
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
@@ -72,3 +75,86 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1       string
+		cidr2       string
+		overlap     bool
+		expectError bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, false},
+		{"10.0.1.0/24", "10.0.0.0/16", true, false},
+		{"10.0.0.0/24", "10.0.1.0/24", false, false},
+		{"10.0.0.0/16", "10.0.0.0/16", true, false},
+		{"2001:db8::/32", "2001:db8:1::/48", true, false},
+		{"2001:db8::/32", "2001:db9::/32", false, false},
+		{"0.0.0.0/0", "::/0", false, false},
+		{"10.0.0.1/16", "10.0.0.0/16", false, true},
+		{"", "10.0.0.0/16", false, true},
+	} {
+		overlap, err := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if got, want := err != nil, ts.expectError; got != want {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) err %t, want %t", ts.cidr1, ts.cidr2, got, want)
+		}
+		if err == nil && overlap != ts.overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.overlap)
+		}
+	}
+}
+
+func TestSubnetUsableIPAddressCount(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr        string
+		count       string
+		expectError bool
+	}{
+		{"10.0.0.0/16", "65531", false},
+		{"10.0.0.0/24", "251", false},
+		{"10.0.0.0/28", "11", false},
+		{"10.0.0.0/30", "0", false},
+		{"10.0.0.0/32", "0", false},
+		{"2001:db8::/64", "18446744073709551611", false},
+		{"10.0.0.1/24", "", true},
+	} {
+		count, err := SubnetUsableIPAddressCount(ts.cidr)
+		if got, want := err != nil, ts.expectError; got != want {
+			t.Fatalf("SubnetUsableIPAddressCount(%q) err %t, want %t", ts.cidr, got, want)
+		}
+		if err == nil && count.String() != ts.count {
+			t.Fatalf("SubnetUsableIPAddressCount(%q) = %s, want %s", ts.cidr, count, ts.count)
+		}
+	}
+}
+
+func TestSplitCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr        string
+		count       int
+		blocks      []string
+		expectError bool
+	}{
+		{"10.0.0.0/16", 1, []string{"10.0.0.0/16"}, false},
+		{"10.0.0.0/16", 2, []string{"10.0.0.0/17", "10.0.128.0/17"}, false},
+		{"10.0.0.0/16", 3, []string{"10.0.0.0/18", "10.0.64.0/18", "10.0.128.0/18"}, false},
+		{"10.0.0.0/24", 4, []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/26", "10.0.0.192/26"}, false},
+		{"2001:db8::/56", 2, []string{"2001:db8::/57", "2001:db8:0:80::/57"}, false},
+		{"10.0.0.0/31", 3, nil, true},
+		{"10.0.0.0/16", 0, nil, true},
+		{"10.0.0.1/16", 2, nil, true},
+	} {
+		blocks, err := SplitCIDRBlock(ts.cidr, ts.count)
+		if got, want := err != nil, ts.expectError; got != want {
+			t.Fatalf("SplitCIDRBlock(%q, %d) err %t, want %t", ts.cidr, ts.count, got, want)
+		}
+		if err == nil && !slices.Equal(blocks, ts.blocks) {
+			t.Fatalf("SplitCIDRBlock(%q, %d) = %v, want %v", ts.cidr, ts.count, blocks, ts.blocks)
+		}
+	}
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -18,12 +20,158 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Can the resource's Region be overridden via the top-level `region` attribute?
+}
+
+// ResourceRegionDefault returns the default resource Region information:
+// a regional resource whose Region can be overridden.
+func ResourceRegionDefault() ServicePackageResourceRegion {
+	return ServicePackageResourceRegion{
+		IsOverrideEnabled: true,
+	}
+}
+
+// ResourceRegionDisabled returns the resource Region information for
+// a regional resource whose Region cannot be overridden.
+func ResourceRegionDisabled() ServicePackageResourceRegion {
+	return ServicePackageResourceRegion{}
+}
+
+// ResourceRegionGlobal returns the resource Region information for a global resource.
+func ResourceRegionGlobal() ServicePackageResourceRegion {
+	return ServicePackageResourceRegion{
+		IsGlobal: true,
+	}
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+type ServicePackageResourceIdentity struct {
+	IsGlobalResource bool                // Is the resource global? Global resources have no `region` identity attribute.
+	IsSingleton      bool                // Is the resource a singleton, identified by only its account ID and Region?
+	IsARN            bool                // Is the resource identified by its ARN?
+	IDAttribute      string              // The identity attribute whose value is the resource's `id`. Empty for composite identities.
+	Attributes       []IdentityAttribute // All identity attributes.
+}
+
+// IsEnabled returns whether the resource has an identity.
+func (i ServicePackageResourceIdentity) IsEnabled() bool {
+	return len(i.Attributes) > 0
+}
+
+// ParameterAttributes returns the identity attributes that are neither `account_id` nor `region`.
+func (i ServicePackageResourceIdentity) ParameterAttributes() []IdentityAttribute {
+	var attributes []IdentityAttribute
+
+	for _, v := range i.Attributes {
+		if !i.IsARN && (v.Name == names.AttrAccountID || v.Name == names.AttrRegion) {
+			continue
+		}
+		attributes = append(attributes, v)
+	}
+
+	return attributes
+}
+
+// IdentityAttribute represents a single resource identity attribute.
+type IdentityAttribute struct {
+	Name     string
+	Required bool // Is the attribute required when importing by identity?
+}
+
+// StringIdentityAttribute returns a string-valued identity attribute.
+func StringIdentityAttribute(name string, required bool) IdentityAttribute {
+	return IdentityAttribute{
+		Name:     name,
+		Required: required,
+	}
+}
+
+// RegionalARNIdentity returns the identity for a regional resource identified by its ARN.
+func RegionalARNIdentity(attribute string) ServicePackageResourceIdentity {
+	return arnIdentity(false, attribute)
+}
+
+// GlobalARNIdentity returns the identity for a global resource identified by its ARN.
+func GlobalARNIdentity(attribute string) ServicePackageResourceIdentity {
+	return arnIdentity(true, attribute)
+}
+
+func arnIdentity(isGlobalResource bool, attribute string) ServicePackageResourceIdentity {
+	return ServicePackageResourceIdentity{
+		IsGlobalResource: isGlobalResource,
+		IsARN:            true,
+		IDAttribute:      attribute,
+		Attributes: []IdentityAttribute{
+			StringIdentityAttribute(attribute, true),
+		},
+	}
+}
+
+// RegionalParameterizedIdentity returns the identity for a regional resource identified by
+// its account ID, Region and the specified attributes.
+func RegionalParameterizedIdentity(attributes ...IdentityAttribute) ServicePackageResourceIdentity {
+	return parameterizedIdentity(false, attributes...)
+}
+
+// GlobalParameterizedIdentity returns the identity for a global resource identified by
+// its account ID and the specified attributes.
+func GlobalParameterizedIdentity(attributes ...IdentityAttribute) ServicePackageResourceIdentity {
+	return parameterizedIdentity(true, attributes...)
+}
+
+func parameterizedIdentity(isGlobalResource bool, attributes ...IdentityAttribute) ServicePackageResourceIdentity {
+	identity := ServicePackageResourceIdentity{
+		IsGlobalResource: isGlobalResource,
+		Attributes:       identityBaseAttributes(isGlobalResource),
+	}
+	identity.Attributes = append(identity.Attributes, attributes...)
+	if len(attributes) == 1 {
+		identity.IDAttribute = attributes[0].Name
+	}
+
+	return identity
+}
+
+// RegionalSingletonIdentity returns the identity for a regional singleton resource.
+// The resource's `id` is its Region.
+func RegionalSingletonIdentity() ServicePackageResourceIdentity {
+	return ServicePackageResourceIdentity{
+		IsSingleton: true,
+		Attributes:  identityBaseAttributes(false),
+	}
+}
+
+// GlobalSingletonIdentity returns the identity for a global singleton resource.
+// The resource's `id` is its account ID.
+func GlobalSingletonIdentity() ServicePackageResourceIdentity {
+	return ServicePackageResourceIdentity{
+		IsGlobalResource: true,
+		IsSingleton:      true,
+		Attributes:       identityBaseAttributes(true),
+	}
+}
+
+func identityBaseAttributes(isGlobalResource bool) []IdentityAttribute {
+	attributes := []IdentityAttribute{
+		StringIdentityAttribute(names.AttrAccountID, false),
+	}
+	if !isGlobalResource {
+		attributes = append(attributes, StringIdentityAttribute(names.AttrRegion, false))
+	}
+
+	return attributes
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -33,6 +181,7 @@ type ServicePackageFrameworkDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -42,6 +191,8 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   ServicePackageResourceRegion
+	Identity ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -51,6 +202,17 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   ServicePackageResourceRegion
+}
+
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// that lists instances of a Terraform Plugin SDK resource implemented by a service package.
+// TypeName must be the type name of the listed Terraform Plugin SDK resource.
+type ServicePackageSDKListResource struct {
+	Factory  func(context.Context) (list.ListResourceWithConfigure, error)
+	TypeName string
+	Name     string
+	Region   ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -60,4 +222,6 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   ServicePackageResourceRegion
+	Identity ServicePackageResourceIdentity
 }
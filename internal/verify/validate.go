@@ -29,7 +29,7 @@ import (
 
 var accountIDRegexp = regexache.MustCompile(`^(aws|aws-managed|third-party|aws-marketplace|\d{12}|cw.{10})$`)
 var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
-var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d{1,2}$`)
+var RegionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d{1,2}$`)
 
 // validates all listed in https://gist.github.com/shortjared/4c1e3fe52bdfa47522cfe5b41e5d6f22
 var servicePrincipalRegexp = regexache.MustCompile(`^([0-9a-z-]+\.){1,4}(amazonaws|amazon)\.com$`)
@@ -120,8 +120,8 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, partitionRegexp))
 		}
 
-		if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, regionRegexp))
+		if parsedARN.Region != "" && !RegionRegexp.MatchString(parsedARN.Region) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, RegionRegexp))
 		}
 
 		if parsedARN.AccountID != "" && !accountIDRegexp.MatchString(parsedARN.AccountID) {
@@ -398,7 +398,7 @@ func ValidOnceAWeekWindowFormat(v any, k string) (ws []string, errors []error) {
 }
 
 var (
-	ValidRegionName = validation.StringMatch(regionRegexp, "must be a valid AWS Region Code")
+	ValidRegionName = validation.StringMatch(RegionRegexp, "must be a valid AWS Region Code")
 )
 
 func ValidStringIsJSONOrYAML(v any, k string) (ws []string, errors []error) {
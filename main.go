@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
@@ -26,7 +27,15 @@ func main() {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Configure(ctx)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
@@ -44,6 +53,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Flush any buffered spans.
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
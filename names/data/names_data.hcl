@@ -45,6 +45,7 @@ service "account" {
   provider_package_correct = "account"
   doc_prefix               = ["account_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "acm" {
@@ -859,6 +860,7 @@ service "bcmdataexports" {
   provider_package_correct = "bcmdataexports"
   doc_prefix               = ["bcmdataexports_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "billing" {
@@ -885,6 +887,7 @@ service "billing" {
   provider_package_correct = "billing"
   doc_prefix               = ["billing_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "billingconductor" {
@@ -959,6 +962,7 @@ service "ce" {
   provider_package_correct = "ce"
   doc_prefix               = ["ce_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "chatbot" {
@@ -1303,6 +1307,7 @@ service "cloudfront" {
   provider_package_correct = "cloudfront"
   doc_prefix               = ["cloudfront_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudfrontkeyvaluestore" {
@@ -1337,6 +1342,7 @@ service "cloudfrontkeyvaluestore" {
   provider_package_correct = "cloudfrontkeyvaluestore"
   doc_prefix               = ["cloudfrontkeyvaluestore_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudhsmv2" {
@@ -2328,6 +2334,7 @@ service "costoptimizationhub" {
   provider_package_correct = "costoptimizationhub"
   doc_prefix               = ["costoptimizationhub_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cur" {
@@ -2360,6 +2367,7 @@ service "cur" {
   provider_package_correct = "cur"
   doc_prefix               = ["cur_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "dataexchange" {
@@ -2403,6 +2411,10 @@ service "datapipeline" {
     correct = "aws_datapipeline_"
   }
 
+  tags {
+    max_count = 10
+  }
+
   provider_package_correct = "datapipeline"
   doc_prefix               = ["datapipeline_"]
   brand                    = "AWS"
@@ -3088,6 +3100,10 @@ service "elasticsearch" {
     correct = "aws_es_"
   }
 
+  tags {
+    max_count = 10
+  }
+
   provider_package_correct = "es"
   doc_prefix               = ["elasticsearch_"]
   brand                    = "AWS"
@@ -3172,6 +3188,7 @@ service "invoicing" {
   provider_package_correct = "invoicing"
   doc_prefix               = ["invoicing_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "mediaconnect" {
@@ -3733,6 +3750,7 @@ service "globalaccelerator" {
   provider_package_correct = "globalaccelerator"
   doc_prefix               = ["globalaccelerator_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "glue" {
@@ -3916,6 +3934,7 @@ service "iam" {
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "inspector" {
@@ -5328,6 +5347,7 @@ service "meta" {
   exclude                  = true
   allowed_subcategory      = true
   note                     = "Not an AWS service (metadata)"
+  is_global                = true
 }
 
 service "mgh" {
@@ -5623,6 +5643,7 @@ service "networkmanager" {
   provider_package_correct = "networkmanager"
   doc_prefix               = ["networkmanager_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "nimble" {
@@ -5699,6 +5720,10 @@ service "opensearch" {
     correct = "aws_opensearch_"
   }
 
+  tags {
+    max_count = 10
+  }
+
   provider_package_correct = "opensearch"
   doc_prefix               = ["opensearch_"]
   brand                    = "AWS"
@@ -5820,6 +5845,7 @@ service "organizations" {
   provider_package_correct = "organizations"
   doc_prefix               = ["organizations_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "outposts" {
@@ -6708,9 +6734,14 @@ service "route53" {
     correct = "aws_route53_"
   }
 
+  tags {
+    max_count = 10
+  }
+
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53domains" {
@@ -6737,6 +6768,7 @@ service "route53domains" {
   provider_package_correct = "route53domains"
   doc_prefix               = ["route53domains_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53profiles" {
@@ -6816,6 +6848,7 @@ service "route53recoverycontrolconfig" {
   provider_package_correct = "route53recoverycontrolconfig"
   doc_prefix               = ["route53recoverycontrolconfig_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53recoveryreadiness" {
@@ -6847,6 +6880,7 @@ service "route53recoveryreadiness" {
   provider_package_correct = "route53recoveryreadiness"
   doc_prefix               = ["route53recoveryreadiness_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53resolver" {
@@ -7500,6 +7534,7 @@ service "shield" {
   provider_package_correct = "shield"
   doc_prefix               = ["shield_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "signer" {
@@ -7991,6 +8026,7 @@ service "taxsettings" {
   provider_package_correct = "taxsettings"
   doc_prefix               = ["taxsettings_"]
   brand                    = "Amazon"
+  is_global                = true
 }
 
 service "textract" {
@@ -8269,6 +8305,7 @@ service "waf" {
   provider_package_correct = "waf"
   doc_prefix               = ["waf_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wafregional" {
@@ -8321,6 +8358,7 @@ service "budgets" {
   provider_package_correct = "budgets"
   doc_prefix               = ["budgets_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wellarchitected" {
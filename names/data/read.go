@@ -238,6 +238,31 @@ func (sr ServiceRecord) EndpointRegionOverrides() map[string]string {
 	return nil
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal
+}
+
+func (sr ServiceRecord) TagsMaxCount() int {
+	if sr.service.ServiceTags != nil {
+		return sr.service.ServiceTags.MaxCount
+	}
+	return 0
+}
+
+func (sr ServiceRecord) TagsKeyMaxLength() int {
+	if sr.service.ServiceTags != nil {
+		return sr.service.ServiceTags.KeyMaxLength
+	}
+	return 0
+}
+
+func (sr ServiceRecord) TagsValueMaxLength() int {
+	if sr.service.ServiceTags != nil {
+		return sr.service.ServiceTags.ValueMaxLength
+	}
+	return 0
+}
+
 func (sr ServiceRecord) Note() string {
 	return sr.service.Note
 }
@@ -322,6 +347,12 @@ type EndpointInfo struct {
 	EndpointOnly            bool              `hcl:"endpoint_only,optional"`
 }
 
+type Tags struct {
+	MaxCount       int `hcl:"max_count,optional"`
+	KeyMaxLength   int `hcl:"key_max_length,optional"`
+	ValueMaxLength int `hcl:"value_max_length,optional"`
+}
+
 type Service struct {
 	ProviderPackage       string         `hcl:",label"`
 	ServiceCli            *CLIV2Command  `hcl:"cli_v2_command,block"`
@@ -332,6 +363,7 @@ type Service struct {
 	ServiceEnvVars        *EnvVar        `hcl:"env_var,block"`
 	ServiceEndpoints      *EndpointInfo  `hcl:"endpoint_info,block"`
 	ServiceResourcePrefix ResourcePrefix `hcl:"resource_prefix,block"`
+	ServiceTags           *Tags          `hcl:"tags,block"`
 
 	SubService []Service `hcl:"sub_service,block"`
 
@@ -341,6 +373,7 @@ type Service struct {
 	DocPrefix                     []string `hcl:"doc_prefix,optional"`
 	Brand                         string   `hcl:"brand,optional"`
 	Exclude                       bool     `hcl:"exclude,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
 	Note                          string   `hcl:"note,optional"`
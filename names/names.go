@@ -16,6 +16,7 @@
 package names
 
 import (
+	"cmp"
 	"fmt"
 	"log"
 	"slices"
@@ -168,8 +169,24 @@ type serviceDatum struct {
 	brand             string
 	humanFriendly     string
 	providerNameUpper string
+	tagLimits         TagLimits
 }
 
+// TagLimits describes the limits a service places on resource tags.
+type TagLimits struct {
+	MaxCount       int
+	KeyMaxLength   int
+	ValueMaxLength int
+}
+
+const (
+	// Default AWS resource tag limits.
+	// https://docs.aws.amazon.com/tag-editor/latest/userguide/best-practices-and-strats.html#id_tags_naming_best_practices
+	defaultTagsMaxCount       = 50
+	defaultTagsKeyMaxLength   = 128
+	defaultTagsValueMaxLength = 256
+)
+
 // serviceData key is the AWS provider service package
 var serviceData map[string]serviceDatum
 
@@ -206,6 +223,11 @@ func readHCLIntoServiceData() error {
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
 			providerNameUpper: l.ProviderNameUpper(),
+			tagLimits: TagLimits{
+				MaxCount:       cmp.Or(l.TagsMaxCount(), defaultTagsMaxCount),
+				KeyMaxLength:   cmp.Or(l.TagsKeyMaxLength(), defaultTagsKeyMaxLength),
+				ValueMaxLength: cmp.Or(l.TagsValueMaxLength(), defaultTagsValueMaxLength),
+			},
 		}
 
 		a := []string{p}
@@ -278,6 +300,19 @@ func FullHumanFriendly(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// ServiceTagLimits returns the resource tag limits for the specified service package name or alias.
+func ServiceTagLimits(service string) (TagLimits, error) {
+	if v, ok := serviceData[service]; ok {
+		return v.tagLimits, nil
+	}
+
+	if s, err := ProviderPackageForAlias(service); err == nil {
+		return ServiceTagLimits(s)
+	}
+
+	return TagLimits{}, fmt.Errorf("no service data found for %s", service)
+}
+
 func HumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		return v.humanFriendly, nil
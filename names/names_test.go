@@ -386,3 +386,60 @@ func TestFullHumanFriendly(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceTagLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected TagLimits
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "default",
+			Input:    EC2,
+			Expected: TagLimits{MaxCount: 50, KeyMaxLength: 128, ValueMaxLength: 256},
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: TagLimits{MaxCount: 10, KeyMaxLength: 128, ValueMaxLength: 256},
+		},
+		{
+			TestName: "alias",
+			Input:    "opensearchservice",
+			Expected: TagLimits{MaxCount: 10, KeyMaxLength: 128, ValueMaxLength: 256},
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ServiceTagLimits(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%v) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}